@@ -0,0 +1,151 @@
+package protoenum
+
+import (
+	"fmt"
+	"slices"
+)
+
+// WithAliasNames registers extra names resolving to the Enum of the proto, e.g. retired names
+// LookupByName, GetByName and ParseByName return the canonical Enum, ListXxx stay canonical
+// Panics with *ConflictError when a name already belongs to another Enum
+// Panics with *UnknownValueError when the proto is not in the collection
+// Panics with ErrFrozen once the collection is frozen
+//
+// 注册解析到该 proto 对应 Enum 的额外名称，例如已弃用的名称
+// LookupByName、GetByName 和 ParseByName 返回规范的 Enum，ListXxx 仍只包含规范项
+// 当名称已属于其他 Enum 时以 *ConflictError panic
+// 当 proto 不在集合中时以 *UnknownValueError panic
+// 集合冻结后会以 ErrFrozen panic
+func (c *Enums[P, B, M]) WithAliasNames(proto P, names ...string) *Enums[P, B, M] {
+	c.mustMutable()
+	if err := c.addAliasNames(proto, names...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// WithAliasCodes registers extra codes resolving to the Enum of the proto, e.g. legacy numbers
+// LookupByCode, GetByCode and ParseByCode return the canonical Enum, ListXxx stay canonical
+// Panics with *ConflictError when a code already belongs to another Enum
+// Panics with *UnknownValueError when the proto is not in the collection
+// Panics with ErrFrozen once the collection is frozen
+//
+// 注册解析到该 proto 对应 Enum 的额外代码，例如旧的编号
+// LookupByCode、GetByCode 和 ParseByCode 返回规范的 Enum，ListXxx 仍只包含规范项
+// 当代码已属于其他 Enum 时以 *ConflictError panic
+// 当 proto 不在集合中时以 *UnknownValueError panic
+// 集合冻结后会以 ErrFrozen panic
+func (c *Enums[P, B, M]) WithAliasCodes(proto P, codes ...int32) *Enums[P, B, M] {
+	c.mustMutable()
+	if err := c.addAliasCodes(proto, codes...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// WithAliasBasics registers extra basic values resolving to the Enum of the proto
+// LookupByBasic, GetByBasic and ParseByBasic return the canonical Enum, ListBasics stays canonical
+// Panics with *ConflictError when a basic value already belongs to another Enum
+// Panics with *UnknownValueError when the proto is not in the collection
+// Panics with ErrFrozen once the collection is frozen
+//
+// 注册解析到该 proto 对应 Enum 的额外 basic 值
+// LookupByBasic、GetByBasic 和 ParseByBasic 返回规范的 Enum，ListBasics 仍只包含规范项
+// 当 basic 值已属于其他 Enum 时以 *ConflictError panic
+// 当 proto 不在集合中时以 *UnknownValueError panic
+// 集合冻结后会以 ErrFrozen panic
+func (c *Enums[P, B, M]) WithAliasBasics(proto P, basics ...B) *Enums[P, B, M] {
+	c.mustMutable()
+	if err := c.addAliasBasics(proto, basics...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// addAliasNames maps each name to the Enum of the proto, then refreshes the relaxed name index
+//
+// addAliasNames 将各名称映射到该 proto 对应的 Enum，然后刷新宽松名称索引
+func (c *Enums[P, B, M]) addAliasNames(proto P, names ...string) error {
+	if err := addAliases(c, proto, ConflictName, c.mapName2Enum, names); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if !slices.Contains(c.aliasNames, name) {
+			c.aliasNames = append(c.aliasNames, name)
+		}
+	}
+	if c.nameMatches != 0 {
+		return c.setNameMatch(c.nameMatches)
+	}
+	return nil
+}
+
+// addAliasCodes maps each code to the Enum of the proto, then rebuilds the dense index
+//
+// addAliasCodes 将各代码映射到该 proto 对应的 Enum，然后重建紧凑索引
+func (c *Enums[P, B, M]) addAliasCodes(proto P, codes ...int32) error {
+	if err := addAliases(c, proto, ConflictCode, c.mapCode2Enum, codes); err != nil {
+		return err
+	}
+	c.denseCodes = newDenseIndex(c.mapCode2Enum)
+	return nil
+}
+
+// addAliasBasics maps each basic value to the Enum of the proto
+//
+// addAliasBasics 将各 basic 值映射到该 proto 对应的 Enum
+func (c *Enums[P, B, M]) addAliasBasics(proto P, basics ...B) error {
+	return addAliases(c, proto, ConflictBasic, c.mapBasicEnum, basics)
+}
+
+// addAliases maps each alias to the Enum of the proto, checking all of them before writing any
+// Returns *UnknownValueError when the proto is not registered, *ConflictError when aliases are taken
+// Aliases already mapped to the same Enum are accepted again
+//
+// addAliases 将各别名映射到该 proto 对应的 Enum，写入前先检查全部别名
+// proto 未注册时返回 *UnknownValueError，别名已被占用时返回 *ConflictError
+// 已映射到同一 Enum 的别名可再次注册
+func addAliases[P ProtoEnum, B comparable, M any, K comparable](c *Enums[P, B, M], proto P, kind ConflictKind, mapping map[K]*Enum[P, B, M], aliases []K) error {
+	enum, ok := c.mapProtoEnum[proto]
+	if !ok {
+		return c.newUnknownValueError(LookupProto, fmt.Sprint(proto))
+	}
+	var conflicts []Conflict
+	for _, alias := range aliases {
+		if holder, ok := mapping[alias]; ok && holder != enum {
+			conflicts = append(conflicts, Conflict{
+				Kind:  kind,
+				Index: slices.Index(c.enumElements, enum),
+				Prior: slices.Index(c.enumElements, holder),
+				Value: fmt.Sprint(alias),
+			})
+		}
+	}
+	if len(conflicts) > 0 {
+		return &ConflictError{Conflicts: conflicts}
+	}
+	for _, alias := range aliases {
+		mapping[alias] = enum
+	}
+	return nil
+}
+
+// addDescriptorAliases maps the alias names declared with allow_alias to the Enum sharing their number
+//
+// addDescriptorAliases 将通过 allow_alias 声明的别名名称映射到共享同一数字的 Enum
+func (c *Enums[P, B, M]) addDescriptorAliases() {
+	if c.descriptor == nil {
+		return
+	}
+	values := c.descriptor.Values()
+	for i := 0; i < values.Len(); i++ {
+		name := string(values.Get(i).Name())
+		if _, ok := c.mapName2Enum[name]; ok {
+			continue
+		}
+		if enum, ok := c.mapCode2Enum[int32(values.Get(i).Number())]; ok {
+			c.mapName2Enum[name] = enum
+			c.aliasNames = append(c.aliasNames, name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumlegacy"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_WithAlias tests alias names, codes and basics resolve to the canonical Enum
+// Checks the ListXxx functions keep returning only canonical entries
+//
+// 验证别名名称、代码和 basic 值解析到规范的 Enum
+// 测试 ListXxx 函数仍只返回规范项
+func TestEnums_WithAlias(t *testing.T) {
+	enums := newStatusEnums().
+		WithAliasNames(protoenumstatus.StatusEnum_SUCCESS, "OK", "PASSED").
+		WithAliasCodes(protoenumstatus.StatusEnum_FAILURE, 20, -2).
+		WithAliasBasics(protoenumstatus.StatusEnum_SUCCESS, "ok", "passed")
+
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.MustGetByName("OK").Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.GetByName("PASSED").Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enums.MustGetByCode(20).Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enums.MustGetByCode(-2).Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.MustGetByBasic("ok").Proto())
+
+	enum, err := enums.ParseByBasic("passed")
+	require.NoError(t, err)
+	require.Equal(t, "success", enum.Basic())
+	require.Equal(t, "SUCCESS", enum.Name())
+
+	require.Equal(t, []string{"unknown", "success", "failure"}, enums.ListBasics())
+	require.Len(t, enums.ListProtos(), 3)
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetByCode(3).Proto())
+}
+
+// TestEnums_WithAlias_Conflict tests aliases taken by another Enum are rejected
+//
+// 验证已被其他 Enum 占用的别名会被拒绝
+func TestEnums_WithAlias_Conflict(t *testing.T) {
+	enums := newStatusEnums()
+	var conflictError *protoenum.ConflictError
+	require.ErrorAs(t, recoverError(func() { enums.WithAliasNames(protoenumstatus.StatusEnum_SUCCESS, "FAILURE") }), &conflictError)
+	require.ErrorAs(t, recoverError(func() { enums.WithAliasCodes(protoenumstatus.StatusEnum_SUCCESS, 2) }), &conflictError)
+	require.ErrorAs(t, recoverError(func() { enums.WithAliasBasics(protoenumstatus.StatusEnum_SUCCESS, "ok", "failure") }), &conflictError)
+	var unknownValueError *protoenum.UnknownValueError
+	require.ErrorAs(t, recoverError(func() { enums.WithAliasNames(protoenumstatus.StatusEnum(9), "NINE") }), &unknownValueError)
+	// Rejected aliases are not partly registered // 被拒绝的别名不会被部分注册
+	_, ok := enums.LookupByBasic("ok")
+	require.False(t, ok)
+	// Re-registering the same alias is accepted // 重复注册相同的别名可以接受
+	require.NotPanics(t, func() { enums.WithAliasCodes(protoenumstatus.StatusEnum_SUCCESS, 1, 10, 10) })
+
+	_, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success")).
+		AliasNames(protoenumstatus.StatusEnum_SUCCESS, "Ok").
+		AliasNames(protoenumstatus.StatusEnum_UNKNOWN, "OK").
+		NameMatch(protoenum.NameMatchCaseFold).
+		Build()
+	require.Error(t, err)
+	t.Log(err)
+	require.True(t, errors.As(err, &conflictError))
+	require.Equal(t, protoenum.Conflict{Kind: protoenum.ConflictName, Index: 0, Prior: 1, Value: "OK"}, conflictError.Conflicts[0])
+}
+
+// TestEnums_WithAlias_NameMatch tests alias names join the relaxed name index
+//
+// 验证别名名称加入宽松名称索引
+func TestEnums_WithAlias_NameMatch(t *testing.T) {
+	enums, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success")).
+		AliasNames(protoenumstatus.StatusEnum_SUCCESS, "OK").
+		AliasCodes(protoenumstatus.StatusEnum_SUCCESS, 100).
+		NameMatch(protoenum.NameMatchCaseFold | protoenum.NameMatchPrefix).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.MustGetByName("status_enum_ok").Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.MustGetByCode(100).Proto())
+}
+
+// TestNewEnums_AllowAlias tests allow_alias enums accept repeated protos and resolve alias names
+//
+// 验证 allow_alias 枚举接受重复的 proto 并解析别名名称
+func TestNewEnums_AllowAlias(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_UNKNOWN, "unknown"),
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_SUCCESS, "success"),
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_OK, "ok"),
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_FAILURE, "failure"),
+	)
+	require.Equal(t, []string{"unknown", "success", "failure"}, enums.ListBasics())
+	require.Equal(t, "success", enums.MustGetByBasic("ok").Basic())
+	require.Equal(t, "success", enums.MustGetByName("OK").Basic())
+	require.Equal(t, "failure", enums.MustGetByName("ERROR").Basic())
+
+	_, err := protoenum.TryNewEnums(
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_SUCCESS, "success"),
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_FAILURE, "failure"),
+		protoenum.NewEnum(protoenumlegacy.LegacyEnum_ERROR, "success"),
+	)
+	require.Error(t, err)
+	t.Log(err)
+
+	// Without allow_alias the repeated proto still conflicts // 未设置 allow_alias 时重复的 proto 仍然冲突
+	_, err = protoenum.TryNewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success"),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "ok"),
+	)
+	require.Error(t, err)
+}
+
+// TestNewEnumsFromOptions_AllowAlias tests descriptor-driven collections list canonical values and resolve alias names and basics
+// Checks the same lookups work on dynamic collections built over the descriptor
+//
+// 验证由描述符驱动的集合只列出规范值，并解析别名名称和 basic 值
+// 测试基于该描述符构建的动态集合支持同样的查找
+func TestNewEnumsFromOptions_AllowAlias(t *testing.T) {
+	enums := protoenum.NewEnumsFromOptions[protoenumlegacy.LegacyEnum]()
+	require.Equal(t, []string{"unknown", "success", "failure"}, enums.ListBasics())
+	require.Equal(t, "failure", enums.MustGetByName("ERROR").Basic())
+	require.Equal(t, "success", enums.MustGetByBasic("ok").Basic())
+	require.Equal(t, "failure", enums.MustGetByBasic("error").Basic())
+	require.NoError(t, enums.CheckComplete())
+
+	dynamic := protoenum.NewDynamicEnums(protoenumlegacy.LegacyEnum_UNKNOWN.Descriptor(), protoenum.NamingOptionBasic)
+	require.Equal(t, []string{"unknown", "success", "failure"}, dynamic.ListBasics())
+	require.Equal(t, "success", dynamic.MustGetByBasic("ok").Basic())
+}
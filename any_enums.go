@@ -0,0 +1,101 @@
+package protoenum
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AnyEnum is the non-generic form of an Enum, keeping the metadata as any
+// Lets docs exporters and admin endpoints consume Enums without knowing P, B and M
+//
+// AnyEnum 是 Enum 的非泛型形式，元数据以 any 保存
+// 使文档导出工具和管理接口无需知道 P、B、M 即可使用 Enums
+type AnyEnum struct {
+	Code       int32                            // Numeric code // 数字代码
+	Name       string                           // Name string, e.g. SUCCESS // 名称字符串，例如 SUCCESS
+	Basic      string                           // Basic value formatted with fmt, e.g. success // 使用 fmt 格式化的 basic 值，例如 success
+	Desc       string                           // Description when the metadata exposes Desc(), else empty // 元数据提供 Desc() 时的描述，否则为空
+	Meta       any                              // Metadata, e.g. *MetaDesc // 元数据，例如 *MetaDesc
+	Descriptor protoreflect.EnumValueDescriptor // Value descriptor, nil when P has no descriptor // 枚举值描述符，P 没有描述符时为 nil
+}
+
+// AnyEnums is the non-generic interface of an Enums collection, implemented by each *Enums
+// Collections of different P, B and M types can share one slice, e.g. []AnyEnums
+// Registry stores and returns collections in this form
+//
+// AnyEnums 是 Enums 集合的非泛型接口，由各 *Enums 实现
+// 不同 P、B、M 类型的集合可放入同一切片，例如 []AnyEnums
+// Registry 以此形式保存和返回集合
+type AnyEnums interface {
+	FullName() string                            // Full name of the proto enum // proto 枚举全名
+	Descriptor() protoreflect.EnumDescriptor     // Enum descriptor, nil when P has no descriptor // 枚举描述符，P 没有描述符时为 nil
+	ListAny() []AnyEnum                          // Each Enum in defined sequence // 按定义次序排列的各 Enum
+	LookupAnyByCode(code int32) (AnyEnum, bool)  // Enum with the code // 具有该代码的 Enum
+	LookupAnyByName(name string) (AnyEnum, bool) // Enum with the name // 具有该名称的 Enum
+}
+
+// Any returns the non-generic form of the Enum
+//
+// 返回 Enum 的非泛型形式
+func (c *Enum[protoEnum, basicEnum, metaType]) Any() AnyEnum {
+	res := AnyEnum{Code: c.Code(), Name: c.Name(), Basic: fmt.Sprint(c.basic), Meta: c.meta}
+	if meta, ok := any(c.meta).(describer); ok {
+		res.Desc = meta.Desc()
+	}
+	if proto, ok := any(c.proto).(describedProto); ok && proto.Descriptor() != nil && !c.unknown {
+		res.Descriptor = proto.Descriptor().Values().ByNumber(c.proto.Number())
+	}
+	return res
+}
+
+// FullName returns the full name of the proto enum, e.g. protoenumstatus.StatusEnum
+// Falls back to the Go type name when the collection has no descriptor
+//
+// 返回 proto 枚举全名，例如 protoenumstatus.StatusEnum
+// 当集合没有描述符时回退为 Go 类型名称
+func (c *Enums[P, B, M]) FullName() string {
+	if c.descriptor != nil {
+		return string(c.descriptor.FullName())
+	}
+	var zero P
+	return fmt.Sprintf("%T", zero)
+}
+
+// Descriptor returns the EnumDescriptor of the collection, nil when P does not expose one
+//
+// 返回集合的 EnumDescriptor，P 无法提供描述符时返回 nil
+func (c *Enums[P, B, M]) Descriptor() protoreflect.EnumDescriptor {
+	return c.descriptor
+}
+
+// ListAny returns the non-generic form of each Enum in defined sequence
+//
+// 按定义次序返回各 Enum 的非泛型形式
+func (c *Enums[P, B, M]) ListAny() []AnyEnum {
+	var res = make([]AnyEnum, 0, len(c.enumElements))
+	for _, item := range c.enumElements {
+		res = append(res, item.Any())
+	}
+	return res
+}
+
+// LookupAnyByCode returns the non-generic form of the Enum with the code, false when not found
+//
+// 返回具有该代码的 Enum 的非泛型形式，未找到时返回 false
+func (c *Enums[P, B, M]) LookupAnyByCode(code int32) (AnyEnum, bool) {
+	if res, ok := c.LookupByCode(code); ok {
+		return res.Any(), true
+	}
+	return AnyEnum{}, false
+}
+
+// LookupAnyByName returns the non-generic form of the Enum with the name, false when not found
+//
+// 返回具有该名称的 Enum 的非泛型形式，未找到时返回 false
+func (c *Enums[P, B, M]) LookupAnyByName(name string) (AnyEnum, bool) {
+	if res, ok := c.LookupByName(name); ok {
+		return res.Any(), true
+	}
+	return AnyEnum{}, false
+}
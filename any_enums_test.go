@@ -0,0 +1,50 @@
+package protoenum_test
+
+import (
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_ListAny tests handling collections of different types through one AnyEnums slice
+//
+// 验证通过同一 AnyEnums 切片处理不同类型的集合
+func TestEnums_ListAny(t *testing.T) {
+	collections := []protoenum.AnyEnums{
+		newStatusEnums(),
+		protoenum.NewEnumsFromOptions[protoenumresult.ResultEnum](),
+		protoenum.NewEnums(protoenum.NewEnum(plainEnum(0), "zero")),
+	}
+
+	items := collections[0].ListAny()
+	require.Len(t, items, 3)
+	require.Equal(t, int32(1), items[1].Code)
+	require.Equal(t, "SUCCESS", items[1].Name)
+	require.Equal(t, "success", items[1].Basic)
+	require.Equal(t, "成功", items[1].Desc)
+	require.Equal(t, "成功", items[1].Meta.(*protoenum.MetaDesc).Desc())
+	require.Equal(t, "protoenumstatus.SUCCESS", string(items[1].Descriptor.FullName()))
+	require.Equal(t, "protoenumstatus.StatusEnum", string(collections[0].Descriptor().FullName()))
+
+	items = collections[1].ListAny()
+	require.Equal(t, "pass", items[1].Basic)
+	t.Log(collections[1].FullName(), collections[1].ListAny())
+
+	require.Nil(t, collections[2].Descriptor())
+	items = collections[2].ListAny()
+	require.Len(t, items, 1)
+	require.Nil(t, items[0].Descriptor)
+	require.IsType(t, &protoenum.MetaNone{}, items[0].Meta)
+}
+
+// TestEnum_Any tests the non-generic form of an unknown Enum has no value descriptor
+//
+// 验证未知 Enum 的非泛型形式不含枚举值描述符
+func TestEnum_Any(t *testing.T) {
+	item := newStatusEnums().GetOrUnknownByProto(protoenumstatus.StatusEnum(7)).Any()
+	require.Equal(t, "7", item.Name)
+	require.Nil(t, item.Descriptor)
+}
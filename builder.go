@@ -0,0 +1,192 @@
+package protoenum
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EnumsBuilder collects Enum instances and configuration, then builds a frozen Enums collection
+// Unlike NewEnums there is no implicit default, the default comes from Default alone
+// Build checks each setting and reports every problem in one joined error
+//
+// EnumsBuilder 收集 Enum 实例和配置，然后构建冻结的 Enums 集合
+// 与 NewEnums 不同，不存在隐式默认值，默认值只来自 Default
+// Build 检查各项配置，并将所有问题合并为一个错误返回
+type EnumsBuilder[P ProtoEnum, B comparable, M any] struct {
+	params         []*Enum[P, B, M]              // Enum instances in the defined sequence // 按定义次序排列的 Enum 实例
+	defaultProto   []P                           // Protos passed to Default, more than one is an error // 传给 Default 的 proto，多于一个时报错
+	defaultValid   *bool                         // Value passed to DefaultValid // 传给 DefaultValid 的值
+	strictStage    bool                          // When true, Default is required // 为 true 时必须配置 Default
+	completeness   bool                          // When true, the descriptor must be covered // 为 true 时必须覆盖描述符
+	nameMatches    NameMatch                     // Relaxed name-matching modes // 宽松名称匹配模式
+	aliasSetups    []func(*Enums[P, B, M]) error // Alias registrations applied in sequence // 按次序执行的别名注册
+	fallbackHook   func(event FallbackEvent)     // Fired when GetByXxx falls back to the default // GetByXxx 回退到默认值时触发
+	deprecatedHook func(event DeprecatedEvent)   // Fired when a lookup resolves to a deprecated value // 查找解析到弃用值时触发
+}
+
+// Build starts an EnumsBuilder, pass the type params explicitly
+//
+// 创建 EnumsBuilder，需显式传入类型参数
+//
+// Example:
+//
+//	enums, err := protoenum.Build[protoenumstatus.StatusEnum, StatusType, *protoenum.MetaNone]().
+//		Add(unknown, success, failure).
+//		Default(protoenumstatus.StatusEnum_UNKNOWN).
+//		Strict().
+//		Complete().
+//		Build()
+func Build[P ProtoEnum, B comparable, M any]() *EnumsBuilder[P, B, M] {
+	return &EnumsBuilder[P, B, M]{}
+}
+
+// Add appends Enum instances in the defined sequence
+//
+// 按定义次序追加 Enum 实例
+func (b *EnumsBuilder[P, B, M]) Add(params ...*Enum[P, B, M]) *EnumsBuilder[P, B, M] {
+	b.params = append(b.params, params...)
+	return b
+}
+
+// Default sets the default Enum using its proto enum value
+//
+// 使用 proto 枚举值设置默认 Enum
+func (b *EnumsBuilder[P, B, M]) Default(proto P) *EnumsBuilder[P, B, M] {
+	b.defaultProto = append(b.defaultProto, proto)
+	return b
+}
+
+// DefaultValid marks the default as active, requires Default
+//
+// 标记默认值是否有效，需要配置 Default
+func (b *EnumsBuilder[P, B, M]) DefaultValid(valid bool) *EnumsBuilder[P, B, M] {
+	b.defaultValid = &valid
+	return b
+}
+
+// Strict requires an explicit Default, Build returns ErrNoDefault otherwise
+//
+// 要求显式配置 Default，否则 Build 返回 ErrNoDefault
+func (b *EnumsBuilder[P, B, M]) Strict() *EnumsBuilder[P, B, M] {
+	b.strictStage = true
+	return b
+}
+
+// Complete requires the Enum instances to cover the proto enum descriptor, see CheckComplete
+//
+// 要求 Enum 实例覆盖 proto 枚举描述符，参见 CheckComplete
+func (b *EnumsBuilder[P, B, M]) Complete() *EnumsBuilder[P, B, M] {
+	b.completeness = true
+	return b
+}
+
+// NameMatch enables relaxed name-matching modes, see WithNameMatch
+//
+// 启用宽松名称匹配模式，参见 WithNameMatch
+func (b *EnumsBuilder[P, B, M]) NameMatch(match NameMatch) *EnumsBuilder[P, B, M] {
+	b.nameMatches = match
+	return b
+}
+
+// AliasNames registers extra names resolving to the Enum of the proto, see WithAliasNames
+//
+// 注册解析到该 proto 对应 Enum 的额外名称，参见 WithAliasNames
+func (b *EnumsBuilder[P, B, M]) AliasNames(proto P, names ...string) *EnumsBuilder[P, B, M] {
+	b.aliasSetups = append(b.aliasSetups, func(res *Enums[P, B, M]) error {
+		return res.addAliasNames(proto, names...)
+	})
+	return b
+}
+
+// AliasCodes registers extra codes resolving to the Enum of the proto, see WithAliasCodes
+//
+// 注册解析到该 proto 对应 Enum 的额外代码，参见 WithAliasCodes
+func (b *EnumsBuilder[P, B, M]) AliasCodes(proto P, codes ...int32) *EnumsBuilder[P, B, M] {
+	b.aliasSetups = append(b.aliasSetups, func(res *Enums[P, B, M]) error {
+		return res.addAliasCodes(proto, codes...)
+	})
+	return b
+}
+
+// AliasBasics registers extra basic values resolving to the Enum of the proto, see WithAliasBasics
+//
+// 注册解析到该 proto 对应 Enum 的额外 basic 值，参见 WithAliasBasics
+func (b *EnumsBuilder[P, B, M]) AliasBasics(proto P, basics ...B) *EnumsBuilder[P, B, M] {
+	b.aliasSetups = append(b.aliasSetups, func(res *Enums[P, B, M]) error {
+		return res.addAliasBasics(proto, basics...)
+	})
+	return b
+}
+
+// FallbackHook sets the callback fired when GetByXxx falls back to the default, see WithFallbackHook
+//
+// 设置 GetByXxx 回退到默认值时触发的回调，参见 WithFallbackHook
+func (b *EnumsBuilder[P, B, M]) FallbackHook(hook func(event FallbackEvent)) *EnumsBuilder[P, B, M] {
+	b.fallbackHook = hook
+	return b
+}
+
+// DeprecatedHook sets the callback fired when a lookup resolves to a deprecated value, see WithDeprecatedHook
+//
+// 设置查找解析到弃用值时触发的回调，参见 WithDeprecatedHook
+func (b *EnumsBuilder[P, B, M]) DeprecatedHook(hook func(event DeprecatedEvent)) *EnumsBuilder[P, B, M] {
+	b.deprecatedHook = hook
+	return b
+}
+
+// Build creates the frozen Enums collection
+// Returns the joined errors: *ConflictError, *UnknownValueError on a missing default,
+// ErrNoDefault, *IncompleteError and misconfigured defaults
+//
+// 创建冻结的 Enums 集合
+// 返回合并后的错误：*ConflictError、默认值不存在时的 *UnknownValueError、
+// ErrNoDefault、*IncompleteError 以及默认值配置错误
+func (b *EnumsBuilder[P, B, M]) Build() (*Enums[P, B, M], error) {
+	var errs []error
+	if len(b.defaultProto) > 1 {
+		errs = append(errs, fmt.Errorf("protoenum: default configured %d times", len(b.defaultProto)))
+	}
+	if len(b.defaultProto) == 0 {
+		if b.strictStage {
+			errs = append(errs, ErrNoDefault)
+		}
+		if b.defaultValid != nil {
+			errs = append(errs, errors.New("protoenum: default valid configured without default"))
+		}
+	}
+
+	res, err := TryNewEnums(b.params...)
+	if err != nil {
+		return nil, errors.Join(append(errs, err)...)
+	}
+	res.defaultValue = nil
+	res.fallbackHook = b.fallbackHook
+	if len(b.defaultProto) > 0 {
+		proto := b.defaultProto[len(b.defaultProto)-1]
+		if enum, ok := res.mapProtoEnum[proto]; ok {
+			res.defaultValue = enum
+			res.defaultValid = b.defaultValid
+		} else {
+			errs = append(errs, res.newUnknownValueError(LookupProto, fmt.Sprint(proto)))
+		}
+	}
+	for _, setup := range b.aliasSetups {
+		if err := setup(res); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := res.setNameMatch(b.nameMatches); err != nil {
+		errs = append(errs, err)
+	}
+	if b.completeness {
+		if err := res.CheckComplete(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	// Set last so lookups made while building do not fire the hook // 最后设置，使构建过程中的查找不触发回调
+	res.deprecatedHook = b.deprecatedHook
+	return res.Freeze(), nil
+}
@@ -0,0 +1,87 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuild tests the builder yields a frozen collection with the configured default
+//
+// 验证构建器生成带有所配置默认值的冻结集合
+func TestBuild(t *testing.T) {
+	enums, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]().
+		Add(protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_UNKNOWN, "unknown", "未知")).
+		Add(
+			protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_SUCCESS, "success", "成功"),
+			protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_FAILURE, "failure", "失败"),
+		).
+		Default(protoenumstatus.StatusEnum_FAILURE).
+		DefaultValid(true).
+		Strict().
+		Complete().
+		Build()
+	require.NoError(t, err)
+	require.True(t, enums.IsFrozen())
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enums.GetDefaultProto())
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enums.GetByCode(9).Proto())
+	require.Len(t, enums.ListValidProtos(), 3)
+	require.Panics(t, func() { enums.UnsetDefault() })
+}
+
+// TestBuild_NoDefault tests there is no implicit first-element default
+//
+// 验证不存在隐式的首元素默认值
+func TestBuild_NoDefault(t *testing.T) {
+	enums, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success")).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, "success", enums.GetByCode(1).Basic())
+	require.Panics(t, func() { enums.GetByCode(9) })
+	require.Len(t, enums.ListValidProtos(), 2)
+}
+
+// TestBuild_Errors tests Build reports each problem in one joined error
+//
+// 验证 Build 将各个问题合并到一个错误中返回
+func TestBuild_Errors(t *testing.T) {
+	_, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "unknown")).
+		DefaultValid(true).
+		Strict().
+		Build()
+	require.Error(t, err)
+	t.Log(err)
+	require.ErrorIs(t, err, protoenum.ErrNoDefault)
+	var conflictError *protoenum.ConflictError
+	require.True(t, errors.As(err, &conflictError))
+	require.Equal(t, protoenum.ConflictBasic, conflictError.Conflicts[0].Kind)
+
+	_, err = protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Default(protoenumstatus.StatusEnum_SUCCESS).
+		Complete().
+		Build()
+	require.Error(t, err)
+	t.Log(err)
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, protoenum.LookupProto, unknownValueError.Kind)
+	var incompleteError *protoenum.IncompleteError
+	require.True(t, errors.As(err, &incompleteError))
+	require.Equal(t, []string{"SUCCESS", "FAILURE"}, incompleteError.MissingNames)
+
+	_, err = protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Default(protoenumstatus.StatusEnum_UNKNOWN).
+		Default(protoenumstatus.StatusEnum_UNKNOWN).
+		Build()
+	require.Error(t, err)
+	t.Log(err)
+}
@@ -0,0 +1,43 @@
+package protoenum
+
+import (
+	"fmt"
+)
+
+// IsClosed reports whether the proto enum is closed, e.g. proto2 or editions enum_type = CLOSED
+// Closed enums reject unknown numbers, open enums (proto3 and the editions default) keep them
+//
+// 判断 proto 枚举是否为封闭枚举，例如 proto2 或 editions 中 enum_type = CLOSED
+// 封闭枚举拒绝未知数字，开放枚举（proto3 和 editions 默认值）保留未知数字
+func (c *Enums[P, B, M]) IsClosed() bool {
+	return descriptorIsClosed(c.descriptor)
+}
+
+// ResolveByCode finds an Enum using its numeric code, honoring the open or closed semantics of the enum
+// On a miss closed enums return *UnknownValueError, open enums return the unknown Enum of GetOrUnknownByCode
+// Open enums return ErrUnknownNumber on a miss when P cannot hold the number, the same as GetOrUnknownByCode
+//
+// 通过数字代码解析 Enum，遵循枚举的开放或封闭语义
+// 查找失败时封闭枚举返回 *UnknownValueError，开放枚举返回 GetOrUnknownByCode 的未知 Enum
+// 与 GetOrUnknownByCode 一致，开放枚举查找失败且 P 无法持有该数字时返回 ErrUnknownNumber
+func (c *Enums[P, B, M]) ResolveByCode(code int32) (*Enum[P, B, M], error) {
+	if c.IsClosed() {
+		return c.ParseByCode(code)
+	}
+	return c.GetOrUnknownByCode(code)
+}
+
+// ResolveByProto finds an Enum using its proto enum, honoring the open or closed semantics of the enum
+// On a miss closed enums return *UnknownValueError, open enums return the unknown Enum of GetOrUnknownByProto
+//
+// 通过 proto 枚举解析 Enum，遵循枚举的开放或封闭语义
+// 查找失败时封闭枚举返回 *UnknownValueError，开放枚举返回 GetOrUnknownByProto 的未知 Enum
+func (c *Enums[P, B, M]) ResolveByProto(proto P) (*Enum[P, B, M], error) {
+	if !c.IsClosed() {
+		return c.GetOrUnknownByProto(proto), nil
+	}
+	if res, ok := c.LookupByProto(proto); ok {
+		return res, nil
+	}
+	return nil, c.newUnknownValueError(LookupProto, fmt.Sprint(proto))
+}
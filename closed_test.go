@@ -0,0 +1,66 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumclosed"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_IsClosed tests closed detection of editions, proto3 and plain enums
+//
+// 验证 editions、proto3 以及普通枚举的封闭性判断
+func TestEnums_IsClosed(t *testing.T) {
+	require.True(t, protoenum.NewEnumsFromOptions[protoenumclosed.ClosedEnum]().IsClosed())
+	require.False(t, newStatusEnums().IsClosed())
+	require.False(t, protoenum.NewEnums(protoenum.NewEnum(plainEnum(0), "zero")).IsClosed())
+}
+
+// TestEnums_ResolveByCode tests closed enums reject unknown numbers while open enums keep them
+//
+// 验证封闭枚举拒绝未知数字，而开放枚举保留未知数字
+func TestEnums_ResolveByCode(t *testing.T) {
+	closed := protoenum.NewEnumsFromOptions[protoenumclosed.ClosedEnum]()
+
+	enum, err := closed.ResolveByCode(1)
+	require.NoError(t, err)
+	require.Equal(t, protoenumclosed.ClosedEnum_ACTIVE, enum.Proto())
+
+	_, err = closed.ResolveByCode(7)
+	require.Error(t, err)
+	t.Log(err)
+	var unknownErr *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownErr))
+	require.Equal(t, protoenum.LookupCode, unknownErr.Kind)
+	require.Equal(t, "protoenumclosed.ClosedEnum", unknownErr.FullName)
+
+	open, err := newStatusEnums().ResolveByCode(7)
+	require.NoError(t, err)
+	require.True(t, open.IsUnknown())
+	require.Equal(t, protoenumstatus.StatusEnum(7), open.Proto())
+}
+
+// TestEnums_ResolveByProto tests resolving proto values of closed and open enums
+//
+// 验证封闭枚举与开放枚举的 proto 值解析
+func TestEnums_ResolveByProto(t *testing.T) {
+	closed := protoenum.NewEnumsFromOptions[protoenumclosed.ClosedEnum]()
+
+	enum, err := closed.ResolveByProto(protoenumclosed.ClosedEnum_ARCHIVED)
+	require.NoError(t, err)
+	require.Equal(t, "archived", enum.Basic())
+
+	_, err = closed.ResolveByProto(protoenumclosed.ClosedEnum(9))
+	require.Error(t, err)
+	t.Log(err)
+	var unknownErr *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownErr))
+	require.Equal(t, protoenum.LookupProto, unknownErr.Kind)
+
+	open, err := newStatusEnums().ResolveByProto(protoenumstatus.StatusEnum(9))
+	require.NoError(t, err)
+	require.True(t, open.IsUnknown())
+}
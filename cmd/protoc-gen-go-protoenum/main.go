@@ -0,0 +1,349 @@
+// Command protoc-gen-go-protoenum: protoc plugin generating protoenum collections from .proto files
+// Emits the Go native basic type, its constants, and a ready-made Enums collection next to each .pb.go
+// Keeps the Go side in step with the .proto so newly added enum values are never forgotten
+//
+// protoc-gen-go-protoenum: 根据 .proto 文件生成 protoenum 集合的 protoc 插件
+// 在每个 .pb.go 旁生成 Go 原生 basic 类型、其常量以及现成的 Enums 集合
+// 使 Go 代码与 .proto 保持同步，避免遗漏新增的枚举值
+//
+// Usage: protoc --go_out=paths=source_relative:. --go-protoenum_out=paths=source_relative:. xxx.proto
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/internal/utils"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// protoenumPackage is the import path of the protoenum runtime package
+// protoenumPackage 是 protoenum 运行时包的导入路径
+const protoenumPackage = protogen.GoImportPath("github.com/go-xlan/protoenum")
+
+func main() {
+	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		for _, file := range gen.Files {
+			if !file.Generate {
+				continue
+			}
+			if _, err := generateFile(gen, file); err != nil {
+				return err
+			}
+		}
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+		return nil
+	})
+}
+
+// generateFile writes the .protoenum.go file holding each enum of the given proto file
+// Skips files without enums so no blank files are emitted
+// Returns an error when two values of one enum map to the same constant name
+// Returns an error when two generated identifiers of the file clash, see checkFileIdents
+//
+// generateFile 为给定 proto 文件中的各枚举写出 .protoenum.go 文件
+// 没有枚举的文件会被跳过，避免生成空文件
+// 当同一枚举中的两个值映射到相同常量名称时返回错误
+// 当文件中两个生成的标识符冲突时返回错误，参见 checkFileIdents
+func generateFile(gen *protogen.Plugin, file *protogen.File) (*protogen.GeneratedFile, error) {
+	enums := collectEnums(file.Enums, file.Messages)
+	if len(enums) == 0 {
+		return nil, nil
+	}
+	var constNames = make([][]string, 0, len(enums))
+	for _, enum := range enums {
+		names, err := enumConstNames(enum)
+		if err != nil {
+			return nil, err
+		}
+		constNames = append(constNames, names)
+	}
+	if err := checkFileIdents(file, enums, constNames); err != nil {
+		return nil, err
+	}
+
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+".protoenum.go", file.GoImportPath)
+	g.P("// Code generated by protoc-gen-go-protoenum. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+	for idx, enum := range enums {
+		generateEnum(g, enum, constNames[idx])
+	}
+	return g, nil
+}
+
+// checkFileIdents checks the identifiers declared in the Go package of the file stay distinct
+// Covers the basic type, collection and constant names of each enum, next to the enum and message names of protoc-gen-go
+// Returns an error naming both owners when two of them map to the same identifier
+//
+// checkFileIdents 检查文件所在 Go 包中声明的标识符互不相同
+// 覆盖各枚举的 basic 类型、集合以及常量名称，以及 protoc-gen-go 生成的枚举和消息名称
+// 当两者映射到相同标识符时返回错误，并指明这两者
+func checkFileIdents(file *protogen.File, enums []*protogen.Enum, constNames [][]string) error {
+	var mapName2Owner = map[string]string{}
+	declare := func(name string, owner string) error {
+		if previous, exists := mapName2Owner[name]; exists {
+			return fmt.Errorf("file %s: %s and %s both map to identifier %s, rename one of them or set distinct (protoenum.basic) options", file.Desc.Path(), previous, owner, name)
+		}
+		mapName2Owner[name] = owner
+		return nil
+	}
+	for _, message := range collectMessages(file.Messages) {
+		if err := declare(message.GoIdent.GoName, "message "+string(message.Desc.FullName())); err != nil {
+			return err
+		}
+	}
+	for _, enum := range enums {
+		if err := declare(enum.GoIdent.GoName, "enum "+string(enum.Desc.FullName())); err != nil {
+			return err
+		}
+	}
+	for idx, enum := range enums {
+		fullName := string(enum.Desc.FullName())
+		if err := declare(basicTypeName(enum), "basic type of enum "+fullName); err != nil {
+			return err
+		}
+		if err := declare(enumsName(enum), "collection of enum "+fullName); err != nil {
+			return err
+		}
+		for num, value := range uniqueValues(enum) {
+			if err := declare(constNames[idx][num], "constant of value "+string(value.Desc.FullName())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectMessages gathers the messages and nested messages in definition sequence, skipping map entries
+//
+// collectMessages 按定义次序收集消息及嵌套消息，跳过 map 条目
+func collectMessages(messages []*protogen.Message) []*protogen.Message {
+	var results []*protogen.Message
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			continue
+		}
+		results = append(results, message)
+		results = append(results, collectMessages(message.Messages)...)
+	}
+	return results
+}
+
+// collectEnums gathers top-level enums and the enums nested in messages in definition sequence
+//
+// collectEnums 按定义次序收集顶层枚举以及嵌套在消息中的枚举
+func collectEnums(enums []*protogen.Enum, messages []*protogen.Message) []*protogen.Enum {
+	var results = append([]*protogen.Enum{}, enums...)
+	for _, message := range messages {
+		if message.Desc.IsMapEntry() {
+			continue
+		}
+		results = append(results, collectEnums(message.Enums, message.Messages)...)
+	}
+	return results
+}
+
+// generateEnum writes the basic type, its constants and the Enums collection of one enum
+// Uses NewEnumWithMeta with NewMetaDesc when any value declares the (protoenum.meta) option
+// Else uses NewEnumWithDesc when any value declares the (protoenum.desc) option, else NewEnum
+//
+// generateEnum 写出单个枚举的 basic 类型、常量以及 Enums 集合
+// 当任一枚举值声明了 (protoenum.meta) 选项时使用 NewEnumWithMeta 搭配 NewMetaDesc
+// 否则当任一枚举值声明了 (protoenum.desc) 选项时使用 NewEnumWithDesc，否则使用 NewEnum
+func generateEnum(g *protogen.GeneratedFile, enum *protogen.Enum, constNames []string) {
+	typeName := basicTypeName(enum)
+	varName := enumsName(enum)
+	values := uniqueValues(enum)
+
+	g.P()
+	g.P("// ", typeName, " represents the Go native enum of ", enum.GoIdent.GoName)
+	g.P("type ", typeName, " string")
+	g.P()
+	g.P("const (")
+	for idx, value := range values {
+		g.P(constNames[idx], " ", typeName, " = ", strconv.Quote(optionBasic(enum, value)))
+	}
+	g.P(")")
+	g.P()
+	g.P("// ", varName, " is the protoenum collection of ", enum.GoIdent.GoName)
+	g.P("var ", varName, " = ", g.QualifiedGoIdent(protoenumPackage.Ident("NewEnums")), "(")
+	withDesc := hasOptionDesc(values)
+	withMeta := hasOptionMeta(values)
+	for idx, value := range values {
+		basicConst := constNames[idx]
+		if withMeta {
+			metaDesc := g.QualifiedGoIdent(protoenumPackage.Ident("NewMetaDesc")) + "(" + strconv.Quote(protoenum.OptionDesc(value.Desc)) + ", " + metaLiteral(protoenum.OptionMeta(value.Desc)) + ")"
+			g.P(g.QualifiedGoIdent(protoenumPackage.Ident("NewEnumWithMeta")), "(", value.GoIdent.GoName, ", ", basicConst, ", ", metaDesc, "),")
+		} else if withDesc {
+			g.P(g.QualifiedGoIdent(protoenumPackage.Ident("NewEnumWithDesc")), "(", value.GoIdent.GoName, ", ", basicConst, ", ", strconv.Quote(protoenum.OptionDesc(value.Desc)), "),")
+		} else {
+			g.P(g.QualifiedGoIdent(protoenumPackage.Ident("NewEnum")), "(", value.GoIdent.GoName, ", ", basicConst, "),")
+		}
+	}
+	g.P(")")
+}
+
+// enumConstNames returns the basic constant name of each unique value, in the sequence of uniqueValues
+// Returns an error naming both values when two of them map to the same constant name
+//
+// enumConstNames 按 uniqueValues 的次序返回各唯一枚举值的 basic 常量名称
+// 当两个值映射到相同常量名称时返回错误，并指明这两个值
+func enumConstNames(enum *protogen.Enum) ([]string, error) {
+	typeName := basicTypeName(enum)
+	values := uniqueValues(enum)
+
+	var results = make([]string, 0, len(values))
+	var mapName2Value = map[string]*protogen.EnumValue{}
+	for _, value := range values {
+		name := typeName + constSuffix(optionBasic(enum, value), value)
+		if previous, exists := mapName2Value[name]; exists {
+			return nil, fmt.Errorf("enum %s: values %s and %s both map to constant %s, set distinct (protoenum.basic) options", enum.Desc.FullName(), previous.Desc.Name(), value.Desc.Name(), name)
+		}
+		mapName2Value[name] = value
+		results = append(results, name)
+	}
+	return results, nil
+}
+
+// constSuffix returns the constant name suffix of the value, built from its basic string
+// Falls back to the proto value name when the basic holds no letters or digits
+//
+// constSuffix 根据 basic 字符串返回枚举值的常量名称后缀
+// 当 basic 不含字母或数字时回退到 proto 枚举值名称
+func constSuffix(basic string, value *protogen.EnumValue) string {
+	if suffix := camelCase(basic); suffix != "" {
+		return suffix
+	}
+	return camelCase(strings.ToLower(string(value.Desc.Name())))
+}
+
+// optionBasic returns the (protoenum.basic) option of the value, deriving it from the name when absent
+//
+// optionBasic 返回枚举值的 (protoenum.basic) 选项，未声明时根据名称推导
+func optionBasic(enum *protogen.Enum, value *protogen.EnumValue) string {
+	if basic := protoenum.OptionBasic(value.Desc); basic != "" {
+		return basic
+	}
+	return utils.BasicName(string(enum.Desc.Name()), string(value.Desc.Name()))
+}
+
+// hasOptionDesc reports whether any value declares the (protoenum.desc) option
+//
+// hasOptionDesc 判断是否有枚举值声明了 (protoenum.desc) 选项
+func hasOptionDesc(values []*protogen.EnumValue) bool {
+	for _, value := range values {
+		if protoenum.OptionDesc(value.Desc) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOptionMeta reports whether any value declares the (protoenum.meta) option
+//
+// hasOptionMeta 判断是否有枚举值声明了 (protoenum.meta) 选项
+func hasOptionMeta(values []*protogen.EnumValue) bool {
+	for _, value := range values {
+		if protoenum.OptionMeta(value.Desc) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// metaLiteral renders the metadata entries as a Go map literal with sorted keys, or nil when blank
+//
+// metaLiteral 将元数据条目渲染成键有序的 Go map 字面量，为空时渲染为 nil
+func metaLiteral(attributes map[string]string) string {
+	if len(attributes) == 0 {
+		return "nil"
+	}
+	var keys = make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var items = make([]string, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, strconv.Quote(key)+": "+strconv.Quote(attributes[key]))
+	}
+	return "map[string]string{" + strings.Join(items, ", ") + "}"
+}
+
+// uniqueValues returns the enum values skipping aliases whose basic repeats one of their number
+// Aliases with their own basic stay, so NewEnums registers that basic as an alias of the first declared name
+//
+// uniqueValues 返回枚举值，跳过 basic 与同数字已有值重复的别名
+// 拥有自己 basic 的别名会保留，使 NewEnums 将该 basic 注册为首个声明名称的别名
+func uniqueValues(enum *protogen.Enum) []*protogen.EnumValue {
+	var results []*protogen.EnumValue
+	var numberBasics = map[int32][]string{}
+	for _, value := range enum.Values {
+		number := int32(value.Desc.Number())
+		basic := optionBasic(enum, value)
+		if slices.Contains(numberBasics[number], basic) {
+			continue
+		}
+		numberBasics[number] = append(numberBasics[number], basic)
+		results = append(results, value)
+	}
+	return results
+}
+
+// basicTypeName derives the Go native type name, e.g. StatusEnum -> StatusType
+//
+// basicTypeName 推导 Go 原生类型名称，例如 StatusEnum -> StatusType
+func basicTypeName(enum *protogen.Enum) string {
+	name := enum.GoIdent.GoName
+	if trimmed := strings.TrimSuffix(name, "Enum"); trimmed != "" {
+		name = trimmed
+	}
+	return name + "Type"
+}
+
+// enumsName derives the collection variable name, e.g. StatusEnum -> StatusEnums and Status -> Statuses
+//
+// enumsName 推导集合变量名称，例如 StatusEnum -> StatusEnums，Status -> Statuses
+func enumsName(enum *protogen.Enum) string {
+	return pluralName(enum.GoIdent.GoName)
+}
+
+// pluralName appends the English plural suffix, "es" after s, x, z, ch and sh, else "s"
+//
+// pluralName 追加英文复数后缀，在 s、x、z、ch 和 sh 之后追加 "es"，否则追加 "s"
+func pluralName(name string) string {
+	for _, suffix := range []string{"s", "x", "z", "ch", "sh"} {
+		if strings.HasSuffix(name, suffix) {
+			return name + "es"
+		}
+	}
+	return name + "s"
+}
+
+// camelCase converts a basic string into a CamelCase identifier, e.g. in_progress -> InProgress
+// Runes other than letters and digits split words and are dropped, e.g. in-progress -> InProgress
+//
+// camelCase 将 basic 字符串转换成驼峰形式的标识符，例如 in_progress -> InProgress
+// 字母和数字以外的字符作为分词符并被丢弃，例如 in-progress -> InProgress
+func camelCase(name string) string {
+	var sb strings.Builder
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, part := range parts {
+		runes := []rune(part)
+		sb.WriteString(string(unicode.ToUpper(runes[0])) + string(runes[1:]))
+	}
+	return sb.String()
+}
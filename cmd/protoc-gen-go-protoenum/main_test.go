@@ -0,0 +1,277 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	protoenumoptions "github.com/go-xlan/protoenum/protos/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumlegacy"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// update rewrites the golden files with the generated output
+// update 使用生成的输出重写 golden 文件
+var update = flag.Bool("update", false, "update golden files")
+
+// newRequest builds a CodeGeneratorRequest from compiled-in file descriptors
+// Mimics what protoc sends when invoked with paths=source_relative, listing imports ahead of importers
+//
+// newRequest 根据编译进来的文件描述符构建 CodeGeneratorRequest
+// 模拟 protoc 以 paths=source_relative 调用时发送的请求，依赖文件排在引用文件之前
+func newRequest(files ...protoreflect.FileDescriptor) *pluginpb.CodeGeneratorRequest {
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String("paths=source_relative"),
+	}
+	var added = map[string]bool{}
+	var addFile func(file protoreflect.FileDescriptor)
+	addFile = func(file protoreflect.FileDescriptor) {
+		if added[file.Path()] {
+			return
+		}
+		added[file.Path()] = true
+		for i := 0; i < file.Imports().Len(); i++ {
+			addFile(file.Imports().Get(i).FileDescriptor)
+		}
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(file))
+	}
+	for _, file := range files {
+		addFile(file)
+		req.FileToGenerate = append(req.FileToGenerate, file.Path())
+	}
+	return req
+}
+
+// runPlugin runs the generator on the request and returns the generated contents mapped by name
+//
+// runPlugin 对请求运行生成器，并返回按名称映射的生成内容
+func runPlugin(t *testing.T, req *pluginpb.CodeGeneratorRequest) map[string]string {
+	gen, err := protogen.Options{}.New(req)
+	require.NoError(t, err)
+	for _, file := range gen.Files {
+		if file.Generate {
+			_, err := generateFile(gen, file)
+			require.NoError(t, err)
+		}
+	}
+	resp := gen.Response()
+	require.Empty(t, resp.GetError())
+
+	results := map[string]string{}
+	for _, file := range resp.GetFile() {
+		results[file.GetName()] = file.GetContent()
+	}
+	return results
+}
+
+// checkGolden compares the generated content with the golden file in testdata
+//
+// checkGolden 将生成的内容与 testdata 中的 golden 文件比较
+func checkGolden(t *testing.T, name string, content string) {
+	path := filepath.Join("testdata", filepath.Base(name)+".golden")
+	if *update {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(data), content)
+}
+
+// TestGenerate_Status tests generation against the StatusEnum proto file
+// Checks the output path sits next to the .pb.go and matches the golden file
+//
+// 验证基于 StatusEnum proto 文件的生成
+// 测试输出路径位于 .pb.go 旁且与 golden 文件一致
+func TestGenerate_Status(t *testing.T) {
+	results := runPlugin(t, newRequest(protoenumstatus.File_protoenumstatus_protoenumstatus_proto))
+	require.Len(t, results, 1)
+
+	content, ok := results["protoenumstatus/protoenumstatus.protoenum.go"]
+	require.True(t, ok)
+	t.Log(content)
+	checkGolden(t, "protoenumstatus.protoenum.go", content)
+}
+
+// TestGenerate_Result tests generation against the ResultEnum proto file
+// Checks each enum value gets a basic constant and a collection entry carrying its (protoenum.meta) entries
+//
+// 验证基于 ResultEnum proto 文件的生成
+// 测试每个枚举值都生成 basic 常量以及携带其 (protoenum.meta) 条目的集合条目
+func TestGenerate_Result(t *testing.T) {
+	results := runPlugin(t, newRequest(protoenumresult.File_protoenumresult_protoenumresult_proto))
+	require.Len(t, results, 1)
+
+	content, ok := results["protoenumresult/protoenumresult.protoenum.go"]
+	require.True(t, ok)
+	t.Log(content)
+	checkGolden(t, "protoenumresult.protoenum.go", content)
+}
+
+// TestGenerate_Legacy tests generation against the LegacyEnum proto file declaring allow_alias
+// Checks alias values get their own basic constant and collection entry, so NewEnums aliases their basics
+//
+// 验证基于声明 allow_alias 的 LegacyEnum proto 文件的生成
+// 测试别名值拥有各自的 basic 常量和集合条目，使 NewEnums 为其 basic 值注册别名
+func TestGenerate_Legacy(t *testing.T) {
+	results := runPlugin(t, newRequest(protoenumlegacy.File_protoenumlegacy_protoenumlegacy_proto))
+	require.Len(t, results, 1)
+
+	content, ok := results["protoenumlegacy/protoenumlegacy.protoenum.go"]
+	require.True(t, ok)
+	t.Log(content)
+	checkGolden(t, "protoenumlegacy.protoenum.go", content)
+}
+
+// TestGenerate_SkipNoEnums tests files without enums emit nothing
+//
+// 验证没有枚举的文件不会生成任何内容
+func TestGenerate_SkipNoEnums(t *testing.T) {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"empty/empty.proto"},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("empty/empty.proto"),
+			Package: proto.String("empty"),
+			Syntax:  proto.String("proto3"),
+			Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/empty;empty")},
+		}},
+	}
+	results := runPlugin(t, req)
+	require.Empty(t, results)
+}
+
+// newTaskFile builds a TaskEnum proto file whose values declare the given (protoenum.basic) options
+// Values with a blank basic declare no option, leaving the basic derived from the value name
+//
+// newTaskFile 构建 TaskEnum proto 文件，其枚举值声明给定的 (protoenum.basic) 选项
+// basic 为空的枚举值不声明选项，其 basic 根据枚举值名称推导
+func newTaskFile(t *testing.T, names []string, basics []string) protoreflect.FileDescriptor {
+	enum := &descriptorpb.EnumDescriptorProto{Name: proto.String("TaskEnum")}
+	for idx, name := range names {
+		value := &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(int32(idx)),
+		}
+		if basics[idx] != "" {
+			value.Options = &descriptorpb.EnumValueOptions{}
+			proto.SetExtension(value.Options, protoenumoptions.E_Basic, basics[idx])
+		}
+		enum.Value = append(enum.Value, value)
+	}
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("protoenumtask/protoenumtask.proto"),
+		Package:    proto.String("protoenumtask"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{protoenumoptions.File_protoenum_options_proto.Path()},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/protoenumtask;protoenumtask")},
+		EnumType:   []*descriptorpb.EnumDescriptorProto{enum},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return file
+}
+
+// TestGenerate_Sanitize tests basics holding non-identifier runes still produce valid constant names
+// Checks runes like '-', ' ' and '.' split words, and basics without letters fall back to the value name
+//
+// 验证含有非标识符字符的 basic 仍能生成合法的常量名称
+// 测试 '-'、' '、'.' 等字符作为分词符，不含字母的 basic 回退到枚举值名称
+func TestGenerate_Sanitize(t *testing.T) {
+	file := newTaskFile(t,
+		[]string{"UNKNOWN", "IN_PROGRESS", "ON_HOLD", "DONE_OK", "CHECKED"},
+		[]string{"", "in-progress", "on hold", "done.ok", "✓"},
+	)
+	results := runPlugin(t, newRequest(file))
+	require.Len(t, results, 1)
+
+	content, ok := results["protoenumtask/protoenumtask.protoenum.go"]
+	require.True(t, ok)
+	t.Log(content)
+	checkGolden(t, "protoenumtask.protoenum.go", content)
+}
+
+// TestGenerate_Collision tests values mapping to the same constant name cause a plugin error
+//
+// 验证映射到相同常量名称的枚举值会导致插件错误
+func TestGenerate_Collision(t *testing.T) {
+	file := newTaskFile(t,
+		[]string{"UNKNOWN", "IN_PROGRESS", "RUNNING"},
+		[]string{"", "in_progress", "inProgress"},
+	)
+	gen, err := protogen.Options{}.New(newRequest(file))
+	require.NoError(t, err)
+	for _, item := range gen.Files {
+		if item.Generate {
+			_, err := generateFile(gen, item)
+			require.Error(t, err)
+			t.Log(err)
+			require.Contains(t, err.Error(), "IN_PROGRESS and RUNNING")
+			require.Contains(t, err.Error(), "TaskTypeInProgress")
+		}
+	}
+}
+
+// TestGenerate_FileCollision tests identifiers of different enums clashing in one file cause a plugin error
+// Checks enums Status and StatusEnum, which both map to the basic type StatusType
+//
+// 验证同一文件中不同枚举的标识符冲突会导致插件错误
+// 测试枚举 Status 和 StatusEnum 都映射到 basic 类型 StatusType
+func TestGenerate_FileCollision(t *testing.T) {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("protoenumclash/protoenumclash.proto"),
+		Package: proto.String("protoenumclash"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/protoenumclash;protoenumclash")},
+		EnumType: []*descriptorpb.EnumDescriptorProto{
+			{Name: proto.String("Status"), Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATUS_UNKNOWN"), Number: proto.Int32(0)},
+			}},
+			{Name: proto.String("StatusEnum"), Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATUS_ENUM_UNKNOWN"), Number: proto.Int32(0)},
+			}},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	gen, err := protogen.Options{}.New(newRequest(file))
+	require.NoError(t, err)
+	for _, item := range gen.Files {
+		if item.Generate {
+			_, err := generateFile(gen, item)
+			require.Error(t, err)
+			t.Log(err)
+			require.Contains(t, err.Error(), "protoenumclash.Status and basic type of enum protoenumclash.StatusEnum")
+			require.Contains(t, err.Error(), "identifier StatusType")
+		}
+	}
+}
+
+// TestPluralName tests collection names get the English plural suffix
+//
+// 验证集合名称追加英文复数后缀
+func TestPluralName(t *testing.T) {
+	require.Equal(t, "StatusEnums", pluralName("StatusEnum"))
+	require.Equal(t, "Statuses", pluralName("Status"))
+	require.Equal(t, "Boxes", pluralName("Box"))
+	require.Equal(t, "Matches", pluralName("Match"))
+}
+
+// TestCamelCase tests basic strings convert into constant name suffixes
+//
+// 验证 basic 字符串转换成常量名称后缀
+func TestCamelCase(t *testing.T) {
+	require.Equal(t, "InProgress", camelCase("in_progress"))
+	require.Equal(t, "Success", camelCase("success"))
+	require.Equal(t, "InProgress", camelCase("in-progress"))
+	require.Equal(t, "OnHold", camelCase("on hold"))
+	require.Equal(t, "V2Beta", camelCase("v2.beta"))
+	require.Equal(t, "", camelCase("✓"))
+}
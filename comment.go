@@ -0,0 +1,127 @@
+package protoenum
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SourceComment returns the leading comment of the enum value in the .proto, falling back to the trailing comment
+// Each line is trimmed, e.g. "// Operation succeeded" -> "Operation succeeded"
+// Returns blank string when the descriptor carries no source info, e.g. built without --include_source_info
+// Generated Go code drops source info, so load the descriptor from a descriptor set to read comments
+// Descriptions built from descriptors prefer this comment over (protoenum.desc) and other explicit descriptions
+//
+// 返回 .proto 中枚举值的前置注释，没有时回退为后置注释
+// 逐行去除首尾空白，例如 "// Operation succeeded" -> "Operation succeeded"
+// 当描述符不含源码信息时返回空字符串，例如构建时未使用 --include_source_info
+// 生成的 Go 代码会丢弃源码信息，因此需要从描述符集合加载描述符才能读取注释
+// 根据描述符构建的描述优先使用该注释，其次才是 (protoenum.desc) 等显式描述
+func SourceComment(value protoreflect.EnumValueDescriptor) string {
+	location := value.ParentFile().SourceLocations().ByDescriptor(value)
+	comment := location.LeadingComments
+	if strings.TrimSpace(comment) == "" {
+		comment = location.TrailingComments
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(comment), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// describeValue returns the description of the enum value, the single precedence rule used across protoenum
+// The source comment comes first, falling back to the explicit description, e.g. (protoenum.desc) or NewEnumWithDesc
+// Shared by NewEnumsFromOptions, TryNewDynamicEnums and WithSourceDesc so the three agree
+//
+// describeValue 返回枚举值的描述，是 protoenum 中统一使用的优先级规则
+// 源码注释优先，没有时回退为显式描述，例如 (protoenum.desc) 或 NewEnumWithDesc 的参数
+// NewEnumsFromOptions、TryNewDynamicEnums 和 WithSourceDesc 共用此函数，使三者保持一致
+func describeValue(value protoreflect.EnumValueDescriptor, explicit string) string {
+	if comment := SourceComment(value); comment != "" {
+		return comment
+	}
+	return explicit
+}
+
+// WithSourceDesc fills the MetaDesc of each Enum with the source comment of its value in the descriptor
+// Pass a descriptor loaded with source info, e.g. from protoc --include_source_info --descriptor_set_out
+// Comments take precedence, values without comments keep the explicit description, the same as TryNewDynamicEnums
+// Changed Enums are replaced by copies holding new MetaDesc, the given Enum instances and other collections stay untouched
+// Enums with other metadata types are skipped
+// Panics with ErrNoDescriptor when the descriptor is nil, and with a wrapped ErrOtherEnum when it names another enum
+// Panics with ErrFrozen once the collection is frozen
+//
+// 使用描述符中各枚举值的源码注释填充对应 Enum 的 MetaDesc
+// 传入带有源码信息的描述符，例如通过 protoc --include_source_info --descriptor_set_out 生成
+// 注释优先，没有注释的枚举值保留显式描述，与 TryNewDynamicEnums 一致
+// 发生变化的 Enum 会被持有新 MetaDesc 的副本替换，传入的 Enum 实例及其他集合不受影响
+// 其他元数据类型的 Enum 会被跳过
+// 描述符为 nil 时以 ErrNoDescriptor panic，描述符对应其他枚举时以包装的 ErrOtherEnum panic
+// 集合冻结后会以 ErrFrozen panic
+func (c *Enums[P, B, M]) WithSourceDesc(desc protoreflect.EnumDescriptor) *Enums[P, B, M] {
+	c.mustMutable()
+	if desc == nil {
+		panic(ErrNoDescriptor)
+	}
+	if string(desc.FullName()) != c.FullName() {
+		panic(fmt.Errorf("%w: %s, expected %s", ErrOtherEnum, desc.FullName(), c.FullName()))
+	}
+	var replacements = map[*Enum[P, B, M]]*Enum[P, B, M]{}
+	for _, item := range c.enumElements {
+		meta, ok := any(item.meta).(*MetaDesc)
+		if !ok || meta == nil {
+			continue
+		}
+		value := desc.Values().ByNumber(item.proto.Number())
+		if value == nil {
+			continue
+		}
+		if description := describeValue(value, meta.description); description != meta.description {
+			clone := *item
+			clone.meta = any(NewMetaDesc(description, meta.attributes)).(M)
+			replacements[item] = &clone
+		}
+	}
+	c.replaceEnums(replacements)
+	return c
+}
+
+// replaceEnums swaps the Enum instances across each index of the collection, including the default
+//
+// replaceEnums 在集合的各索引（包括默认值）中替换 Enum 实例
+func (c *Enums[P, B, M]) replaceEnums(replacements map[*Enum[P, B, M]]*Enum[P, B, M]) {
+	if len(replacements) == 0 {
+		return
+	}
+	swap := func(enum *Enum[P, B, M]) *Enum[P, B, M] {
+		if res, ok := replacements[enum]; ok {
+			return res
+		}
+		return enum
+	}
+	for idx, item := range c.enumElements {
+		c.enumElements[idx] = swap(item)
+	}
+	swapValues(c.mapProtoEnum, swap)
+	swapValues(c.mapCode2Enum, swap)
+	swapValues(c.mapName2Enum, swap)
+	swapValues(c.mapBasicEnum, swap)
+	swapValues(c.mapNameFold, swap)
+	if c.denseCodes != nil {
+		for idx, item := range c.denseCodes.items {
+			c.denseCodes.items[idx] = swap(item)
+		}
+	}
+	c.defaultValue = swap(c.defaultValue)
+}
+
+// swapValues replaces each value of the map with the result of swap
+//
+// swapValues 将映射中的各值替换为 swap 的结果
+func swapValues[K comparable, V any](mapping map[K]V, swap func(V) V) {
+	for key, value := range mapping {
+		mapping[key] = swap(value)
+	}
+}
@@ -0,0 +1,93 @@
+package protoenum_test
+
+import (
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// newStatusSourceDescriptor loads the StatusEnum descriptor with source info, as --include_source_info keeps it
+//
+// 加载带有源码信息的 StatusEnum 描述符，与 --include_source_info 保留的内容一致
+func newStatusSourceDescriptor(t *testing.T) protoreflect.EnumDescriptor {
+	fileProto := protodesc.ToFileDescriptorProto(protoenumstatus.File_protoenumstatus_protoenumstatus_proto)
+	fileProto.SourceCodeInfo = &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+		{Path: []int32{5, 0, 2, 1}, Span: []int32{0, 0, 0}, LeadingComments: proto.String(" Operation done\n 操作完成\n")},
+		{Path: []int32{5, 0, 2, 2}, Span: []int32{0, 0, 0}, TrailingComments: proto.String(" Operation broken\n")},
+	}}
+	file, err := protodesc.NewFile(fileProto, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return file.Enums().ByName("StatusEnum")
+}
+
+// TestSourceComment tests reading leading and trailing comments, and blank results without source info
+//
+// 验证读取前置和后置注释，以及没有源码信息时返回空字符串
+func TestSourceComment(t *testing.T) {
+	desc := newStatusSourceDescriptor(t)
+	require.Equal(t, "", protoenum.SourceComment(desc.Values().ByNumber(0)))
+	require.Equal(t, "Operation done\n操作完成", protoenum.SourceComment(desc.Values().ByNumber(1)))
+	require.Equal(t, "Operation broken", protoenum.SourceComment(desc.Values().ByNumber(2)))
+
+	generated := protoenumstatus.StatusEnum_SUCCESS.Descriptor().Values().ByNumber(1)
+	require.Equal(t, "", protoenum.SourceComment(generated))
+}
+
+// TestEnums_WithSourceDesc tests comments override explicit descriptions, which stay when no comment exists
+// Checks the panic values of a nil descriptor and a descriptor naming another enum
+//
+// 验证注释覆盖显式描述，没有注释时保留显式描述
+// 测试描述符为 nil 以及对应其他枚举时的 panic 值
+func TestEnums_WithSourceDesc(t *testing.T) {
+	enums := newStatusEnums().WithSourceDesc(newStatusSourceDescriptor(t))
+	require.Equal(t, "未知", enums.GetByCode(0).Meta().Desc())
+	require.Equal(t, "Operation done\n操作完成", enums.GetByCode(1).Meta().Desc())
+	require.Equal(t, "Operation broken", enums.GetByCode(2).Meta().Desc())
+
+	err := recoverError(func() {
+		protoenum.NewEnumsFromOptions[protoenumresult.ResultEnum]().WithSourceDesc(newStatusSourceDescriptor(t))
+	})
+	require.ErrorIs(t, err, protoenum.ErrOtherEnum)
+	t.Log(err)
+
+	err = recoverError(func() {
+		newStatusEnums().WithSourceDesc(nil)
+	})
+	require.ErrorIs(t, err, protoenum.ErrNoDescriptor)
+}
+
+// TestEnums_WithSourceDesc_Shared tests the given Enum instances and frozen collections sharing them keep the old description
+// Checks each lookup of the filled collection returns the same new Enum
+//
+// 验证传入的 Enum 实例及共享它们的冻结集合保留原有描述
+// 测试填充后集合的各种查找返回同一个新 Enum
+func TestEnums_WithSourceDesc_Shared(t *testing.T) {
+	success := protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_SUCCESS, "success", "成功")
+	params := []*protoenum.Enum[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]{
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_UNKNOWN, "unknown", "未知"),
+		success,
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_FAILURE, "failure", "失败"),
+	}
+	frozen := protoenum.NewEnums(params...).Freeze()
+	enums := protoenum.NewEnums(params...).WithSourceDesc(newStatusSourceDescriptor(t))
+
+	require.Equal(t, "成功", success.Meta().Desc())
+	require.Equal(t, "成功", frozen.GetByCode(1).Meta().Desc())
+	require.Equal(t, "失败", frozen.GetByName("FAILURE").Meta().Desc())
+
+	res := enums.GetByCode(1)
+	require.NotSame(t, success, res)
+	require.Equal(t, "Operation done\n操作完成", res.Meta().Desc())
+	require.Same(t, res, enums.GetByProto(protoenumstatus.StatusEnum_SUCCESS))
+	require.Same(t, res, enums.GetByName("SUCCESS"))
+	require.Same(t, res, enums.GetByBasic("success"))
+	require.Same(t, params[0], enums.GetDefault())
+}
@@ -0,0 +1,75 @@
+package protoenum
+
+// CheckComplete checks that the collection covers each value of the proto enum descriptor
+// Returns *IncompleteError listing missing numbers and names, and registered codes absent from the descriptor
+// Returns ErrNoDescriptor when the collection has no descriptor
+//
+// 检查集合是否覆盖 proto 枚举描述符中的各枚举值
+// 返回 *IncompleteError，列出缺失的数字和名称，以及描述符中不存在的已注册代码
+// 当集合没有描述符时返回 ErrNoDescriptor
+func (c *Enums[P, B, M]) CheckComplete() error {
+	desc := c.descriptor
+	if desc == nil {
+		return ErrNoDescriptor
+	}
+
+	var missingCodes []int32
+	var missingNames []string
+	var declared = make(map[int32]bool, desc.Values().Len())
+	for idx := 0; idx < desc.Values().Len(); idx++ {
+		value := desc.Values().Get(idx)
+		code := int32(value.Number())
+		// Skip aliases reusing a number // 跳过复用数字的别名
+		if declared[code] {
+			continue
+		}
+		declared[code] = true
+
+		if _, ok := c.mapCode2Enum[code]; !ok {
+			missingCodes = append(missingCodes, code)
+			missingNames = append(missingNames, string(value.Name()))
+		}
+	}
+
+	var unknownCodes []int32
+	for _, item := range c.enumElements {
+		if !declared[item.Code()] {
+			unknownCodes = append(unknownCodes, item.Code())
+		}
+	}
+
+	if len(missingCodes) == 0 && len(unknownCodes) == 0 {
+		return nil
+	}
+	return &IncompleteError{
+		FullName:     string(desc.FullName()),
+		MissingCodes: missingCodes,
+		MissingNames: missingNames,
+		UnknownCodes: unknownCodes,
+	}
+}
+
+// MustComplete checks that the collection covers each value of the proto enum descriptor
+// Panics with *IncompleteError when values are missing or unknown
+//
+// 检查集合是否覆盖 proto 枚举描述符中的各枚举值
+// 存在缺失或未知的枚举值时以 *IncompleteError panic
+func (c *Enums[P, B, M]) MustComplete() {
+	if err := c.CheckComplete(); err != nil {
+		panic(err)
+	}
+}
+
+// WithComplete enforces completeness as an option at construction, e.g. NewEnums(...).WithComplete()
+// Use this in package-scope declarations so a value added to the .proto cannot be forgotten
+// Panics with *IncompleteError when the collection doesn't cover each value of the proto enum descriptor
+// Use EnumsBuilder.Complete to get the error returned instead, the same as TryNewEnums
+//
+// 作为构造选项强制检查完整性，例如 NewEnums(...).WithComplete()
+// 在全局变量声明中使用，避免遗漏 .proto 中新增的枚举值
+// 当集合未覆盖 proto 枚举描述符中的各枚举值时以 *IncompleteError panic
+// 需要返回错误而非 panic 时使用 EnumsBuilder.Complete，与 TryNewEnums 一致
+func (c *Enums[P, B, M]) WithComplete() *Enums[P, B, M] {
+	c.MustComplete()
+	return c
+}
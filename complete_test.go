@@ -0,0 +1,148 @@
+package protoenum_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// plainEnum is a ProtoEnum without protobuf descriptor
+// plainEnum 是没有 protobuf 描述符的 ProtoEnum
+type plainEnum int32
+
+func (x plainEnum) String() string                  { return strconv.Itoa(int(x)) }
+func (x plainEnum) Number() protoreflect.EnumNumber { return protoreflect.EnumNumber(x) }
+
+// TestEnums_CheckComplete tests a collection covering each proto value passes the check
+//
+// 验证覆盖各 proto 枚举值的集合能通过检查
+func TestEnums_CheckComplete(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+		StatusTypeSuccess StatusType = "success"
+		StatusTypeFailure StatusType = "failure"
+	)
+
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_FAILURE, StatusTypeFailure),
+	)
+	require.NoError(t, enums.CheckComplete())
+	require.NotPanics(t, enums.MustComplete)
+}
+
+// TestEnums_CheckComplete_Missing tests missing proto values are reported with numbers and names
+//
+// 验证缺失的 proto 枚举值会连同数字和名称一起报告
+func TestEnums_CheckComplete_Missing(t *testing.T) {
+	type ResultType string
+	const (
+		ResultTypeUnknown ResultType = "unknown"
+		ResultTypePass    ResultType = "pass"
+	)
+
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumresult.ResultEnum_UNKNOWN, ResultTypeUnknown),
+		protoenum.NewEnum(protoenumresult.ResultEnum_PASS, ResultTypePass),
+	)
+
+	err := enums.CheckComplete()
+	require.Error(t, err)
+	t.Log(err)
+
+	var incompleteError *protoenum.IncompleteError
+	require.True(t, errors.As(err, &incompleteError))
+	require.Equal(t, "protoenumresult.ResultEnum", incompleteError.FullName)
+	require.Equal(t, []int32{2, 3}, incompleteError.MissingCodes)
+	require.Equal(t, []string{"MISS", "SKIP"}, incompleteError.MissingNames)
+	require.Empty(t, incompleteError.UnknownCodes)
+
+	require.Panics(t, enums.MustComplete)
+}
+
+// TestEnums_CheckComplete_Unknown tests registered codes absent from the descriptor are reported
+//
+// 验证描述符中不存在的已注册代码会被报告
+func TestEnums_CheckComplete_Unknown(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+		StatusTypeSuccess StatusType = "success"
+		StatusTypeFailure StatusType = "failure"
+		StatusTypeTimeout StatusType = "timeout"
+	)
+
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_FAILURE, StatusTypeFailure),
+		protoenum.NewEnum(protoenumstatus.StatusEnum(7), StatusTypeTimeout),
+	)
+
+	err := enums.CheckComplete()
+	require.Error(t, err)
+	t.Log(err)
+
+	var incompleteError *protoenum.IncompleteError
+	require.True(t, errors.As(err, &incompleteError))
+	require.Empty(t, incompleteError.MissingCodes)
+	require.Equal(t, []int32{7}, incompleteError.UnknownCodes)
+}
+
+// TestEnums_CheckComplete_NoDescriptor tests ErrNoDescriptor when P has no descriptor
+//
+// 验证 P 没有描述符时返回 ErrNoDescriptor
+func TestEnums_CheckComplete_NoDescriptor(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(plainEnum(0), "zero"),
+		protoenum.NewEnum(plainEnum(1), "one"),
+	)
+	require.ErrorIs(t, enums.CheckComplete(), protoenum.ErrNoDescriptor)
+}
+
+// TestEnums_WithComplete tests completeness is enforced as an option at construction
+// Checks the panic value is *IncompleteError listing the missing value
+//
+// 验证作为构造选项强制检查完整性
+// 测试 panic 值是列出缺失枚举值的 *IncompleteError
+func TestEnums_WithComplete(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+		StatusTypeSuccess StatusType = "success"
+		StatusTypeFailure StatusType = "failure"
+	)
+
+	require.NotPanics(t, func() {
+		enums := protoenum.NewEnums(
+			protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+			protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+			protoenum.NewEnum(protoenumstatus.StatusEnum_FAILURE, StatusTypeFailure),
+		).WithComplete()
+		require.Equal(t, StatusTypeUnknown, enums.GetDefaultBasic())
+	})
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		err, ok := r.(error)
+		require.True(t, ok)
+		t.Log(err)
+
+		var incompleteError *protoenum.IncompleteError
+		require.True(t, errors.As(err, &incompleteError))
+		require.Equal(t, []string{"FAILURE"}, incompleteError.MissingNames)
+	}()
+	protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+	).WithComplete()
+}
@@ -0,0 +1,99 @@
+package protoenum
+
+// denseIndex serves code lookups from a slice when the codes fill a compact range
+// Proto enums are almost always numbered 0..N, so a slice index beats map hashing
+// Slots inside the range without an Enum hold nil and count as misses
+//
+// denseIndex 在代码集中于紧凑区间时，使用切片提供代码查找
+// Proto 枚举几乎总是按 0..N 编号，因此切片索引比 map 哈希更快
+// 区间内没有 Enum 的槽位为 nil，视为查找失败
+type denseIndex[P ProtoEnum, B comparable, M any] struct {
+	start int32            // Smallest code, stored at slot 0 // 最小代码，存放在 0 号槽位
+	items []*Enum[P, B, M] // Enums placed at code-start // 按 code-start 放置的 Enum
+}
+
+// newDenseIndex builds the slice index when the codes span at most twice the count of codes
+// Takes the code map so alias codes are served too, returns nil when the codes are sparse
+//
+// 当代码跨度不超过代码数量的两倍时构建切片索引
+// 传入代码映射表以便同时支持别名代码，代码稀疏时返回 nil
+func newDenseIndex[P ProtoEnum, B comparable, M any](mapCode2Enum map[int32]*Enum[P, B, M]) *denseIndex[P, B, M] {
+	if len(mapCode2Enum) == 0 {
+		return nil
+	}
+	var start, end int32
+	var first = true
+	for code := range mapCode2Enum {
+		if first {
+			start, end, first = code, code, false
+		}
+		start = min(start, code)
+		end = max(end, code)
+	}
+	var span = int64(end) - int64(start) + 1
+	if span > 2*int64(len(mapCode2Enum)) {
+		return nil
+	}
+	var res = &denseIndex[P, B, M]{
+		start: start,
+		items: make([]*Enum[P, B, M], span),
+	}
+	for code, item := range mapCode2Enum {
+		res.items[code-start] = item
+	}
+	return res
+}
+
+// lookup returns the Enum stored at the code, false when outside the range or in a gap
+//
+// 返回该代码处存放的 Enum，超出区间或位于空隙时返回 false
+func (d *denseIndex[P, B, M]) lookup(code int32) (*Enum[P, B, M], bool) {
+	var slot = int64(code) - int64(d.start)
+	if slot < 0 || slot >= int64(len(d.items)) {
+		return nil, false
+	}
+	res := d.items[slot]
+	return res, res != nil
+}
+
+// findByCode finds an Enum using its code through the dense index, or the map when sparse
+// Fires the deprecated hook when the found Enum is deprecated
+//
+// 通过紧凑索引查找代码对应的 Enum，代码稀疏时使用映射表
+// 找到的 Enum 已弃用时触发弃用回调
+func (c *Enums[P, B, M]) findByCode(code int32) (*Enum[P, B, M], bool) {
+	var res *Enum[P, B, M]
+	var ok bool
+	if c.denseCodes != nil {
+		res, ok = c.denseCodes.lookup(code)
+	} else {
+		res, ok = c.mapCode2Enum[code]
+	}
+	if ok {
+		c.noticeDeprecated(LookupCode, res)
+	}
+	return res, ok
+}
+
+// findByProto finds an Enum using its proto enum through the dense index, or the map when sparse
+// Checks the proto matches since distinct ProtoEnum values could share one number
+// Fires the deprecated hook when the found Enum is deprecated
+//
+// 通过紧凑索引查找 proto 枚举对应的 Enum，代码稀疏时使用映射表
+// 由于不同的 ProtoEnum 值可能共享同一数字，因此会校验 proto 是否一致
+// 找到的 Enum 已弃用时触发弃用回调
+func (c *Enums[P, B, M]) findByProto(proto P) (*Enum[P, B, M], bool) {
+	var res *Enum[P, B, M]
+	var ok bool
+	if c.denseCodes != nil {
+		res, ok = c.denseCodes.lookup(int32(proto.Number()))
+		ok = ok && res.Proto() == proto
+	} else {
+		res, ok = c.mapProtoEnum[proto]
+	}
+	if !ok {
+		return nil, false
+	}
+	c.noticeDeprecated(LookupProto, res)
+	return res, true
+}
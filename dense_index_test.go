@@ -0,0 +1,142 @@
+package protoenum_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/stretchr/testify/require"
+)
+
+// newPlainEnums builds a plainEnum collection with the code of each value given by codeFn
+//
+// newPlainEnums 构建 plainEnum 集合，各值的代码由 codeFn 给出
+func newPlainEnums(size int, codeFn func(idx int) int32) *protoenum.Enums[plainEnum, string, *protoenum.MetaNone] {
+	var params = make([]*protoenum.Enum[plainEnum, string, *protoenum.MetaNone], 0, size)
+	for idx := 0; idx < size; idx++ {
+		params = append(params, protoenum.NewEnum(plainEnum(codeFn(idx)), "v"+strconv.Itoa(idx)))
+	}
+	return protoenum.NewEnums(params...)
+}
+
+// TestEnums_LookupByCode_Dense tests code lookups with gaps, negative codes and out-of-range codes
+//
+// 验证带空隙、负数代码和超出区间代码的查找
+func TestEnums_LookupByCode_Dense(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(plainEnum(-1), "minus"),
+		protoenum.NewEnum(plainEnum(0), "zero"),
+		protoenum.NewEnum(plainEnum(2), "two"),
+	)
+	for code, basic := range map[int32]string{-1: "minus", 0: "zero", 2: "two"} {
+		enum, ok := enums.LookupByCode(code)
+		require.True(t, ok)
+		require.Equal(t, basic, enum.Basic())
+
+		enum, ok = enums.LookupByProto(plainEnum(code))
+		require.True(t, ok)
+		require.Equal(t, basic, enum.Basic())
+	}
+	for _, code := range []int32{-2, 1, 3, 1 << 30, -1 << 31} {
+		_, ok := enums.LookupByCode(code)
+		require.False(t, ok)
+		require.Equal(t, "minus", enums.GetByCode(code).Basic())
+		require.Panics(t, func() { enums.MustGetByCode(code) })
+	}
+	_, ok := enums.LookupByProto(plainEnum(1))
+	require.False(t, ok)
+}
+
+// TestEnums_LookupByCode_Sparse tests sparse codes are served through the maps
+//
+// 验证稀疏代码通过映射表查找
+func TestEnums_LookupByCode_Sparse(t *testing.T) {
+	enums := newPlainEnums(3, func(idx int) int32 { return int32(idx * 1000) })
+
+	enum, ok := enums.LookupByCode(2000)
+	require.True(t, ok)
+	require.Equal(t, "v2", enum.Basic())
+	require.Equal(t, "v1", enums.MustGetByProto(plainEnum(1000)).Basic())
+
+	_, ok = enums.LookupByCode(1)
+	require.False(t, ok)
+	require.Equal(t, "v0", enums.GetByProto(plainEnum(1)).Basic())
+}
+
+// BenchmarkEnums_GetByCode_Status benchmarks GetByCode on the dense StatusEnum collection served by the slice index
+//
+// 测试基于切片索引的稠密 StatusEnum 集合上 GetByCode 的性能
+func BenchmarkEnums_GetByCode_Status(b *testing.B) {
+	enums := newStatusEnums()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByCode(int32(i % 3))
+	}
+}
+
+// BenchmarkEnums_GetByProto_Status benchmarks GetByProto on the dense StatusEnum collection served by the slice index
+//
+// 测试基于切片索引的稠密 StatusEnum 集合上 GetByProto 的性能
+func BenchmarkEnums_GetByProto_Status(b *testing.B) {
+	enums := newStatusEnums()
+	protos := enums.ListProtos()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByProto(protos[i%len(protos)])
+	}
+}
+
+// BenchmarkEnums_GetByCode_Status_Sparse benchmarks GetByCode on a 3-value sparse collection served by the map index
+//
+// 测试基于 map 索引的 3 值稀疏集合上 GetByCode 的性能
+func BenchmarkEnums_GetByCode_Status_Sparse(b *testing.B) {
+	enums := newPlainEnums(3, func(idx int) int32 { return int32(idx * 1000) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByCode(int32(i % 3 * 1000))
+	}
+}
+
+// BenchmarkEnums_GetByCode_500 benchmarks GetByCode on a 500-value dense collection served by the slice index
+//
+// 测试基于切片索引的 500 值稠密集合上 GetByCode 的性能
+func BenchmarkEnums_GetByCode_500(b *testing.B) {
+	enums := newPlainEnums(500, func(idx int) int32 { return int32(idx) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByCode(int32(i % 500))
+	}
+}
+
+// BenchmarkEnums_GetByCode_500_Sparse benchmarks GetByCode on a 500-value sparse collection served by the map index
+//
+// 测试基于 map 索引的 500 值稀疏集合上 GetByCode 的性能
+func BenchmarkEnums_GetByCode_500_Sparse(b *testing.B) {
+	enums := newPlainEnums(500, func(idx int) int32 { return int32(idx * 1000) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByCode(int32(i % 500 * 1000))
+	}
+}
+
+// BenchmarkEnums_GetByProto_500 benchmarks GetByProto on a 500-value dense collection served by the slice index
+//
+// 测试基于切片索引的 500 值稠密集合上 GetByProto 的性能
+func BenchmarkEnums_GetByProto_500(b *testing.B) {
+	enums := newPlainEnums(500, func(idx int) int32 { return int32(idx) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByProto(plainEnum(i % 500))
+	}
+}
+
+// BenchmarkEnums_GetByProto_500_Sparse benchmarks GetByProto on a 500-value sparse collection served by the map index
+//
+// 测试基于 map 索引的 500 值稀疏集合上 GetByProto 的性能
+func BenchmarkEnums_GetByProto_500_Sparse(b *testing.B) {
+	enums := newPlainEnums(500, func(idx int) int32 { return int32(idx * 1000) })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enums.GetByProto(plainEnum(i % 500 * 1000))
+	}
+}
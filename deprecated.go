@@ -0,0 +1,113 @@
+package protoenum
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// DeprecatedEvent describes a lookup resolving to a value marked [deprecated = true]
+//
+// DeprecatedEvent 描述一次解析到标记为 [deprecated = true] 的值的查找
+type DeprecatedEvent struct {
+	FullName string     // Full name of the proto enum, e.g. protoenumplan.PlanEnum // proto 枚举全名
+	Kind     LookupKind // Identifier used in the lookup // 查找时使用的标识符类型
+	Name     string     // Name of the deprecated value, e.g. LEGACY // 弃用值的名称，例如 LEGACY
+	Code     int32      // Code of the deprecated value // 弃用值的代码
+}
+
+// Deprecated reports whether the proto value is marked [deprecated = true] in the .proto
+// The flag is read from the descriptor once when the Enum is built, so checks cost a field read
+// Returns false when the proto enum has no descriptor or the Enum is unknown
+//
+// 判断 proto 值是否在 .proto 中标记为 [deprecated = true]
+// 该标记在构建 Enum 时从描述符读取一次，因此判断只需读取字段
+// 当 proto 枚举没有描述符或 Enum 为未知时返回 false
+func (c *Enum[protoEnum, basicEnum, metaType]) Deprecated() bool {
+	return c.deprecated
+}
+
+// protoDeprecated reads the [deprecated = true] option of the proto value off its descriptor
+// Returns false when the proto enum has no descriptor or the number is not declared
+//
+// protoDeprecated 从描述符中读取 proto 值的 [deprecated = true] 选项
+// 当 proto 枚举没有描述符或数字未声明时返回 false
+func protoDeprecated[P ProtoEnum](proto P) bool {
+	value := valueDescriptor(proto)
+	if value == nil {
+		return false
+	}
+	options, ok := value.Options().(*descriptorpb.EnumValueOptions)
+	return ok && options.GetDeprecated()
+}
+
+// ListActiveProtos returns the protoEnum values of ListValidProtos excluding deprecated values
+//
+// 返回 ListValidProtos 中排除弃用值后的 protoEnum 值
+func (c *Enums[P, B, M]) ListActiveProtos() []P {
+	var results []P
+	for _, item := range c.enumElements {
+		if !c.isSkipped(item) && !item.Deprecated() {
+			results = append(results, item.Proto())
+		}
+	}
+	return results
+}
+
+// ListActiveBasics returns the basicEnum values of ListValidBasics excluding deprecated values
+//
+// 返回 ListValidBasics 中排除弃用值后的 basicEnum 值
+func (c *Enums[P, B, M]) ListActiveBasics() []B {
+	var results []B
+	for _, item := range c.enumElements {
+		if !c.isSkipped(item) && !item.Deprecated() {
+			results = append(results, item.Basic())
+		}
+	}
+	return results
+}
+
+// WithDeprecatedHook sets the callback fired whenever a lookup resolves to a deprecated value
+// Covers LookupByXxx, GetByXxx, MustGetByXxx, ParseByXxx and GetOrUnknownByXxx, ListXxx and iterators do not fire it
+// The hook runs on the goroutine of the lookup, so it can record the caller before the value is deleted
+// Panics with ErrFrozen once the collection is frozen
+//
+// 设置查找解析到弃用值时触发的回调
+// 覆盖 LookupByXxx、GetByXxx、MustGetByXxx、ParseByXxx 和 GetOrUnknownByXxx，ListXxx 和迭代器不会触发
+// 回调在执行查找的 goroutine 上运行，因此可在删除该值之前记录调用方
+// 集合冻结后会以 ErrFrozen panic
+//
+// Example:
+//
+//	enums.WithDeprecatedHook(protoenum.ZapDeprecatedHook(zap.L()))
+func (c *Enums[P, B, M]) WithDeprecatedHook(hook func(event DeprecatedEvent)) *Enums[P, B, M] {
+	c.mustMutable()
+	c.deprecatedHook = hook
+	return c
+}
+
+// noticeDeprecated fires the deprecated hook when the found Enum is deprecated
+// Costs a nil check when no hook is set, so lookups stay fast
+//
+// noticeDeprecated 在找到的 Enum 已弃用时触发弃用回调
+// 未设置回调时只需一次 nil 判断，使查找保持高效
+func (c *Enums[P, B, M]) noticeDeprecated(kind LookupKind, item *Enum[P, B, M]) {
+	if c.deprecatedHook == nil || !item.Deprecated() {
+		return
+	}
+	c.deprecatedHook(DeprecatedEvent{FullName: c.FullName(), Kind: kind, Name: item.Name(), Code: item.Code()})
+}
+
+// ZapDeprecatedHook returns a deprecated hook logging each event as a warning with the stack of the caller
+//
+// 返回以警告级别记录各事件并附带调用方堆栈的弃用回调
+func ZapDeprecatedHook(logger *zap.Logger) func(event DeprecatedEvent) {
+	return func(event DeprecatedEvent) {
+		logger.Warn("protoenum: lookup resolved to deprecated value",
+			zap.String("enum", event.FullName),
+			zap.String("kind", string(event.Kind)),
+			zap.String("name", event.Name),
+			zap.Int32("code", event.Code),
+			zap.Stack("stack"),
+		)
+	}
+}
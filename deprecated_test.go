@@ -0,0 +1,136 @@
+package protoenum_test
+
+import (
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumplan"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// legacyPlan is the deprecated PlanEnum value, built from its number to keep linters quiet
+//
+// legacyPlan 是已弃用的 PlanEnum 值，通过数字构建以避免 linter 警告
+const legacyPlan = protoenumplan.PlanEnum(3)
+
+// TestEnum_Deprecated tests the deprecated flag comes from the descriptor
+// Checks the flag is set by each constructor, including dynamic collections
+//
+// 验证弃用标记来自描述符
+// 测试各构造函数（包括动态集合）都会设置该标记
+func TestEnum_Deprecated(t *testing.T) {
+	enums := protoenum.NewEnumsFromOptions[protoenumplan.PlanEnum]()
+	require.True(t, enums.GetByProto(legacyPlan).Deprecated())
+	require.False(t, enums.GetByProto(protoenumplan.PlanEnum_BASIC).Deprecated())
+	require.False(t, enums.GetOrUnknownByProto(protoenumplan.PlanEnum(9)).Deprecated())
+	require.False(t, protoenum.NewEnum(plainEnum(3), "three").Deprecated())
+
+	require.True(t, protoenum.NewEnum(legacyPlan, "legacy").Deprecated())
+	require.True(t, protoenum.NewEnumWithDesc(legacyPlan, "legacy", "旧版").Deprecated())
+
+	dynamic := protoenum.NewDynamicEnums(legacyPlan.Descriptor(), protoenum.NamingLower)
+	require.True(t, dynamic.GetByCode(3).Deprecated())
+	require.False(t, dynamic.GetByCode(1).Deprecated())
+}
+
+// TestEnums_ListActiveBasics tests active lists exclude the default and deprecated values
+//
+// 验证有效列表排除默认值和弃用值
+func TestEnums_ListActiveBasics(t *testing.T) {
+	enums := protoenum.NewEnumsFromOptions[protoenumplan.PlanEnum]()
+	require.Equal(t, []string{"basic", "premium", "legacy"}, enums.ListValidBasics())
+	require.Equal(t, []string{"basic", "premium"}, enums.ListActiveBasics())
+	require.Equal(t, []protoenumplan.PlanEnum{protoenumplan.PlanEnum_BASIC, protoenumplan.PlanEnum_PREMIUM}, enums.ListActiveProtos())
+
+	enums.SetDefaultValid(true)
+	require.Equal(t, []string{"unknown", "basic", "premium"}, enums.ListActiveBasics())
+}
+
+// TestEnums_WithDeprecatedHook tests the hook fires on each lookup kind resolving to a deprecated value
+// Checks active values and list calls do not fire the hook
+//
+// 验证各查找类型解析到弃用值时回调都会触发
+// 测试有效值和列表调用不会触发回调
+func TestEnums_WithDeprecatedHook(t *testing.T) {
+	var events []protoenum.DeprecatedEvent
+	enums := protoenum.NewEnumsFromOptions[protoenumplan.PlanEnum]().WithDeprecatedHook(func(event protoenum.DeprecatedEvent) {
+		events = append(events, event)
+	})
+
+	enums.GetByCode(1)
+	enums.ListBasics()
+	enums.GetByCode(3)
+	enums.MustGetByName("LEGACY")
+	_, err := enums.ParseByBasic("legacy")
+	require.NoError(t, err)
+	enums.LookupByProto(legacyPlan)
+
+	fullName := "protoenumplan.PlanEnum"
+	require.Equal(t, []protoenum.DeprecatedEvent{
+		{FullName: fullName, Kind: protoenum.LookupCode, Name: "LEGACY", Code: 3},
+		{FullName: fullName, Kind: protoenum.LookupName, Name: "LEGACY", Code: 3},
+		{FullName: fullName, Kind: protoenum.LookupBasic, Name: "LEGACY", Code: 3},
+		{FullName: fullName, Kind: protoenum.LookupProto, Name: "LEGACY", Code: 3},
+	}, events)
+}
+
+// TestEnums_WithDeprecatedHook_Config tests configuring a deprecated default does not fire the hook
+// Checks later lookups of that value still fire it
+//
+// 验证将弃用值配置为默认值不会触发回调
+// 测试之后对该值的查找仍会触发回调
+func TestEnums_WithDeprecatedHook_Config(t *testing.T) {
+	var events []protoenum.DeprecatedEvent
+	enums := protoenum.NewEnumsFromOptions[protoenumplan.PlanEnum]().WithDeprecatedHook(func(event protoenum.DeprecatedEvent) {
+		events = append(events, event)
+	})
+
+	enums.WithUnsetDefault().WithDefaultProto(legacyPlan)
+	enums.WithUnsetDefault().WithDefaultBasic("legacy")
+	enums.WithUnsetDefault().WithDefaultCode(3)
+	enums.WithUnsetDefault().WithDefaultName("LEGACY")
+	require.Empty(t, events)
+	require.Equal(t, "legacy", enums.GetDefaultBasic())
+
+	enums.GetByCode(3)
+	require.Len(t, events, 1)
+}
+
+// TestZapDeprecatedHook tests the zap hook logs a warning with the deprecated value and the stack
+//
+// 验证 zap 回调以警告级别记录弃用值和调用堆栈
+func TestZapDeprecatedHook(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	enums := protoenum.NewEnumsFromOptions[protoenumplan.PlanEnum]().WithDeprecatedHook(protoenum.ZapDeprecatedHook(zap.New(core)))
+
+	require.Equal(t, "legacy", enums.GetByCode(3).Basic())
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	t.Log(entry.Message)
+	require.Equal(t, "LEGACY", entry.ContextMap()["name"])
+	require.Contains(t, entry.ContextMap()["stack"], "TestZapDeprecatedHook")
+}
+
+// TestBuild_DeprecatedHook tests the builder sets the hook without firing it while building
+//
+// 验证构建器设置回调，且构建过程中不会触发
+func TestBuild_DeprecatedHook(t *testing.T) {
+	var events []protoenum.DeprecatedEvent
+	enums, err := protoenum.Build[protoenumplan.PlanEnum, string, *protoenum.MetaNone]().
+		Add(
+			protoenum.NewEnum(protoenumplan.PlanEnum_UNKNOWN, "unknown"),
+			protoenum.NewEnum(legacyPlan, "legacy"),
+		).
+		Default(legacyPlan).
+		DeprecatedHook(func(event protoenum.DeprecatedEvent) {
+			events = append(events, event)
+		}).
+		Build()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	require.Equal(t, "legacy", enums.GetByName("LEGACY").Basic())
+	require.Len(t, events, 1)
+}
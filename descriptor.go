@@ -0,0 +1,163 @@
+package protoenum
+
+import (
+	"github.com/yyle88/must"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// enumDescriptor returns the EnumDescriptor of the protoEnum type P
+// Generated protobuf enums implement protoreflect.Enum, so the zero value exposes the descriptor
+// Returns false when P does not implement protoreflect.Enum
+//
+// 返回 protoEnum 类型 P 的 EnumDescriptor
+// 生成的 protobuf 枚举实现了 protoreflect.Enum，因此零值即可获取描述符
+// 当 P 未实现 protoreflect.Enum 时返回 false
+func enumDescriptor[P ProtoEnum]() (protoreflect.EnumDescriptor, bool) {
+	var zero P
+	if enum, ok := any(zero).(protoreflect.Enum); ok {
+		return enum.Descriptor(), true
+	}
+	return nil, false
+}
+
+// mustEnumDescriptor returns the EnumDescriptor of the protoEnum type P
+// Panics when P does not implement protoreflect.Enum
+//
+// 返回 protoEnum 类型 P 的 EnumDescriptor
+// 当 P 未实现 protoreflect.Enum 时会 panic
+func mustEnumDescriptor[P ProtoEnum]() protoreflect.EnumDescriptor {
+	desc, ok := enumDescriptor[P]()
+	must.True(ok)
+	return desc
+}
+
+// describedProto is implemented by proto enum values exposing their EnumDescriptor, e.g. DynamicProto
+//
+// describedProto 由可提供 EnumDescriptor 的 proto 枚举值实现，例如 DynamicProto
+type describedProto interface {
+	Descriptor() protoreflect.EnumDescriptor
+}
+
+// valueDescriptor returns the EnumValueDescriptor declaring the number of the proto
+// Returns nil when the proto enum has no descriptor or the descriptor does not declare the number
+//
+// 返回声明该 proto 数字的 EnumValueDescriptor
+// 当 proto 枚举没有描述符或描述符未声明该数字时返回 nil
+func valueDescriptor[P ProtoEnum](proto P) protoreflect.EnumValueDescriptor {
+	described, ok := any(proto).(describedProto)
+	if !ok || described.Descriptor() == nil {
+		return nil
+	}
+	return described.Descriptor().Values().ByNumber(proto.Number())
+}
+
+// paramsDescriptor returns the EnumDescriptor of the collection built from the params
+// Uses the zero value of P when P is a generated enum, else the first param exposing a descriptor
+//
+// 返回由这些参数构建的集合的 EnumDescriptor
+// P 为生成的枚举时使用 P 的零值，否则使用首个可提供描述符的参数
+func paramsDescriptor[P ProtoEnum, B comparable, M any](params []*Enum[P, B, M]) protoreflect.EnumDescriptor {
+	if desc, ok := enumDescriptor[P](); ok {
+		return desc
+	}
+	for _, item := range params {
+		if item == nil {
+			continue
+		}
+		if proto, ok := any(item.proto).(describedProto); ok && proto.Descriptor() != nil {
+			return proto.Descriptor()
+		}
+	}
+	return nil
+}
+
+// descriptorAllowAlias reports whether the proto enum sets option allow_alias = true
+//
+// 判断 proto 枚举是否设置了 option allow_alias = true
+func descriptorAllowAlias(desc protoreflect.EnumDescriptor) bool {
+	if desc == nil {
+		return false
+	}
+	options, ok := desc.Options().(*descriptorpb.EnumOptions)
+	return ok && options.GetAllowAlias()
+}
+
+// descriptorIsClosed reports whether the proto enum is closed, e.g. proto2 or editions enum_type = CLOSED
+// Reads the enum-level enum_type feature first, since generated descriptors only resolve file-level features
+// Types without a descriptor count as open, matching proto3 semantics
+//
+// 判断 proto 枚举是否为封闭枚举，例如 proto2 或 editions 中 enum_type = CLOSED
+// 优先读取枚举级别的 enum_type 特性，因为生成代码的描述符只解析文件级别的特性
+// 没有描述符的类型视为开放枚举，与 proto3 语义一致
+func descriptorIsClosed(desc protoreflect.EnumDescriptor) bool {
+	if desc == nil {
+		return false
+	}
+	if options, ok := desc.Options().(*descriptorpb.EnumOptions); ok {
+		if features := options.GetFeatures(); features != nil && features.EnumType != nil {
+			return features.GetEnumType() == descriptorpb.FeatureSet_CLOSED
+		}
+	}
+	return desc.IsClosed()
+}
+
+// newProtoEnum creates the protoEnum value of type P with the given number
+// Uses protoreflect.EnumType.New so the result is the genuine generated enum value
+//
+// 使用给定数字创建类型 P 的 protoEnum 值
+// 通过 protoreflect.EnumType.New 创建，结果即生成的枚举值
+func newProtoEnum[P ProtoEnum](number protoreflect.EnumNumber) P {
+	var zero P
+	enum, ok := any(zero).(protoreflect.Enum)
+	must.True(ok)
+	res, ok := enum.Type().New(number).(P)
+	must.True(ok)
+	return res
+}
+
+// NewEnumsFromDescriptor creates an Enums collection covering each value of P's descriptor
+// Walks P.Descriptor().Values() in definition sequence and instantiates each P via protoreflect.EnumType.New
+// The basicFn and metaFn callbacks compute the basic value and metadata of each proto value
+// The zero-numbered value becomes the default, falling back to the first value when zero is not declared
+// Aliases reusing a number map to the first declared name, see newEnumsFromValues
+//
+// 创建覆盖 P 描述符中各枚举值的 Enums 集合
+// 按定义次序遍历 P.Descriptor().Values()，并通过 protoreflect.EnumType.New 实例化各 P
+// basicFn 和 metaFn 回调用于计算各 proto 值的 basic 值和元数据
+// 数字为零的枚举值成为默认值，未声明零值时回退为第一个值
+// 复用数字的别名映射到首个声明的名称，参见 newEnumsFromValues
+func NewEnumsFromDescriptor[P ProtoEnum, B comparable, M any](basicFn func(P) B, metaFn func(P) M) *Enums[P, B, M] {
+	return newEnumsFromValues(func(proto P, _ protoreflect.EnumValueDescriptor) B {
+		return basicFn(proto)
+	}, func(proto P, _ protoreflect.EnumValueDescriptor) M {
+		return metaFn(proto)
+	})
+}
+
+// newEnumsFromValues creates an Enums collection covering each value of P's descriptor, aliases included
+// The callbacks receive the value descriptor too, so aliases sharing a number can compute their own basic
+// Alias entries go through TryNewEnums, which registers their basic values as aliases of the first declared name
+// Panics with *ConflictError when two values map to one basic value
+//
+// newEnumsFromValues 创建覆盖 P 描述符中各枚举值（包括别名）的 Enums 集合
+// 回调同时接收枚举值描述符，使共享数字的别名能计算各自的 basic 值
+// 别名条目交给 TryNewEnums 处理，其 basic 值被注册为首个声明名称的别名
+// 当两个枚举值映射为同一 basic 值时以 *ConflictError panic
+func newEnumsFromValues[P ProtoEnum, B comparable, M any](basicFn func(P, protoreflect.EnumValueDescriptor) B, metaFn func(P, protoreflect.EnumValueDescriptor) M) *Enums[P, B, M] {
+	values := mustEnumDescriptor[P]().Values()
+
+	var params = make([]*Enum[P, B, M], 0, values.Len())
+	for idx := 0; idx < values.Len(); idx++ {
+		value := values.Get(idx)
+		proto := newProtoEnum[P](value.Number())
+		params = append(params, NewEnumWithMeta(proto, basicFn(proto, value), metaFn(proto, value)))
+	}
+
+	res := NewEnums(params...)
+	if enum, ok := res.mapCode2Enum[0]; ok && enum != res.defaultValue {
+		res.UnsetDefault()
+		res.SetDefault(enum)
+	}
+	return res
+}
@@ -0,0 +1,69 @@
+package protoenum_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewEnumsFromDescriptor tests building a collection covering each descriptor value
+// Checks values keep the definition sequence and basic values come from basicFn
+//
+// 验证构建覆盖各描述符值的集合
+// 测试枚举值保持定义次序且 basic 值来自 basicFn
+func TestNewEnumsFromDescriptor(t *testing.T) {
+	type StatusType string
+
+	enums := protoenum.NewEnumsFromDescriptor(func(proto protoenumstatus.StatusEnum) StatusType {
+		return StatusType(strings.ToLower(proto.String()))
+	}, func(proto protoenumstatus.StatusEnum) *protoenum.MetaNone {
+		return &protoenum.MetaNone{}
+	})
+
+	require.Equal(t, []protoenumstatus.StatusEnum{
+		protoenumstatus.StatusEnum_UNKNOWN,
+		protoenumstatus.StatusEnum_SUCCESS,
+		protoenumstatus.StatusEnum_FAILURE,
+	}, enums.ListProtos())
+	require.Equal(t, []StatusType{"unknown", "success", "failure"}, enums.ListBasics())
+
+	enum := enums.GetByBasic("failure")
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enum.Proto())
+	require.Equal(t, "FAILURE", enum.Name())
+}
+
+// TestNewEnumsFromDescriptor_Default tests the zero-numbered value becomes the default
+// Checks lookups that miss fall back to the zero value
+//
+// 验证数字为零的枚举值成为默认值
+// 测试查找失败时回退到零值
+func TestNewEnumsFromDescriptor_Default(t *testing.T) {
+	enums := protoenum.NewEnumsFromDescriptor(func(proto protoenumresult.ResultEnum) int32 {
+		return int32(proto.Number()) * 10
+	}, func(proto protoenumresult.ResultEnum) *protoenum.MetaNone {
+		return &protoenum.MetaNone{}
+	})
+
+	require.Equal(t, protoenumresult.ResultEnum_UNKNOWN, enums.GetDefaultProto())
+	require.Equal(t, int32(0), enums.GetDefaultBasic())
+	require.Equal(t, protoenumresult.ResultEnum_UNKNOWN, enums.GetByCode(100).Proto())
+	require.Equal(t, []int32{10, 20, 30}, enums.ListValidBasics())
+}
+
+// TestNewEnumsFromDescriptor_Meta tests metaFn computes the metadata of each value
+//
+// 验证 metaFn 计算各枚举值的元数据
+func TestNewEnumsFromDescriptor_Meta(t *testing.T) {
+	enums := protoenum.NewEnumsFromDescriptor(func(proto protoenumresult.ResultEnum) string {
+		return proto.String()
+	}, func(proto protoenumresult.ResultEnum) string {
+		return "result-" + strings.ToLower(proto.String())
+	})
+
+	require.Equal(t, "result-pass", enums.GetByProto(protoenumresult.ResultEnum_PASS).Meta())
+	require.Equal(t, "result-skip", enums.GetByName("SKIP").Meta())
+}
@@ -0,0 +1,136 @@
+package protoenum
+
+import (
+	"strconv"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+	"github.com/yyle88/must"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DynamicProto is a proto enum value resolved at runtime from an EnumDescriptor
+// Stands in place of a generated enum type when only descriptors are at hand, e.g. loaded via protodesc.NewFiles
+//
+// DynamicProto 是在运行时从 EnumDescriptor 解析的 proto 枚举值
+// 在只有描述符时替代生成的枚举类型，例如通过 protodesc.NewFiles 加载
+type DynamicProto struct {
+	desc   protoreflect.EnumDescriptor // Descriptor of the enum // 枚举的描述符
+	number protoreflect.EnumNumber     // Number of the value // 枚举值的数字
+}
+
+// NewDynamicProto creates the DynamicProto of the number in the enum descriptor
+//
+// 创建枚举描述符中该数字对应的 DynamicProto
+func NewDynamicProto(desc protoreflect.EnumDescriptor, number protoreflect.EnumNumber) DynamicProto {
+	return DynamicProto{desc: desc, number: number}
+}
+
+// String returns the name of the value, or the number when the descriptor does not declare it
+//
+// 返回枚举值的名称，描述符未声明该数字时返回数字
+func (p DynamicProto) String() string {
+	if p.desc != nil {
+		if value := p.desc.Values().ByNumber(p.number); value != nil {
+			return string(value.Name())
+		}
+	}
+	return strconv.Itoa(int(p.number))
+}
+
+// Number returns the number of the value
+//
+// 返回枚举值的数字
+func (p DynamicProto) Number() protoreflect.EnumNumber {
+	return p.number
+}
+
+// Descriptor returns the descriptor of the enum
+//
+// 返回枚举的描述符
+func (p DynamicProto) Descriptor() protoreflect.EnumDescriptor {
+	return p.desc
+}
+
+// NamingStrategy derives the basic value of an enum value in dynamic collections
+//
+// NamingStrategy 在动态集合中推导枚举值的 basic 值
+type NamingStrategy func(value protoreflect.EnumValueDescriptor) string
+
+// NamingLower strips the enum name prefix and lowers the value name, e.g. STATUS_ENUM_SUCCESS -> success
+//
+// 去除枚举名称前缀并转为小写，例如 STATUS_ENUM_SUCCESS -> success
+func NamingLower(value protoreflect.EnumValueDescriptor) string {
+	return utils.BasicName(string(value.Parent().Name()), string(value.Name()))
+}
+
+// NamingName keeps the value name as the basic value, e.g. SUCCESS -> SUCCESS
+//
+// 将枚举值名称作为 basic 值，例如 SUCCESS -> SUCCESS
+func NamingName(value protoreflect.EnumValueDescriptor) string {
+	return string(value.Name())
+}
+
+// NamingOptionBasic reads the (protoenum.basic) option, falling back to NamingLower
+//
+// 读取 (protoenum.basic) 选项，未声明时回退为 NamingLower
+func NamingOptionBasic(value protoreflect.EnumValueDescriptor) string {
+	if basic := OptionBasic(value); basic != "" {
+		return basic
+	}
+	return NamingLower(value)
+}
+
+// DynamicEnums is the Enums collection built from an EnumDescriptor at runtime
+//
+// DynamicEnums 是在运行时由 EnumDescriptor 构建的 Enums 集合
+type DynamicEnums = Enums[DynamicProto, string, *MetaDesc]
+
+// NewDynamicEnums creates an Enums collection over an EnumDescriptor without generated Go types
+// Panics with *ConflictError when the naming strategy maps two values to one basic value
+//
+// 基于 EnumDescriptor 创建 Enums 集合，无需生成的 Go 类型
+// 当命名策略将两个枚举值映射为同一 basic 值时以 *ConflictError panic
+//
+// Example:
+//
+//	files, _ := protodesc.NewFiles(fileDescriptorSet)
+//	desc, _ := files.FindDescriptorByName("protoenumstatus.StatusEnum")
+//	enums := protoenum.NewDynamicEnums(desc.(protoreflect.EnumDescriptor), protoenum.NamingOptionBasic)
+func NewDynamicEnums(desc protoreflect.EnumDescriptor, naming NamingStrategy) *DynamicEnums {
+	res, err := TryNewDynamicEnums(desc, naming)
+	if err != nil {
+		panic(err)
+	}
+	return must.Full(res)
+}
+
+// TryNewDynamicEnums creates an Enums collection over an EnumDescriptor without panics
+// The naming strategy computes each basic value, descriptions come from source comments, falling back to (protoenum.desc)
+// The zero-numbered value becomes the default, and allow_alias names and basics resolve through LookupByXxx
+// Returns *ConflictError when the naming strategy maps two values to one basic value
+//
+// 基于 EnumDescriptor 创建 Enums 集合，不会 panic
+// 命名策略计算各 basic 值，描述来自源码注释，没有时回退为 (protoenum.desc)
+// 数字为零的枚举值成为默认值，allow_alias 的别名名称和 basic 值可通过 LookupByXxx 解析
+// 当命名策略将两个枚举值映射为同一 basic 值时返回 *ConflictError
+func TryNewDynamicEnums(desc protoreflect.EnumDescriptor, naming NamingStrategy) (*DynamicEnums, error) {
+	values := desc.Values()
+
+	var params = make([]*Enum[DynamicProto, string, *MetaDesc], 0, values.Len())
+	for idx := 0; idx < values.Len(); idx++ {
+		value := values.Get(idx)
+		// Aliases reusing a number go through TryNewEnums, which registers their basic values as aliases
+		// 复用数字的别名交给 TryNewEnums 处理，其 basic 值被注册为别名
+		meta := NewMetaDesc(describeValue(value, OptionDesc(value)), OptionMeta(value))
+		params = append(params, NewEnumWithMeta(NewDynamicProto(desc, value.Number()), naming(value), meta))
+	}
+
+	res, err := TryNewEnums(params...)
+	if err != nil {
+		return nil, err
+	}
+	if enum, ok := res.mapCode2Enum[0]; ok {
+		res.defaultValue = enum
+	}
+	return res, nil
+}
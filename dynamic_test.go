@@ -0,0 +1,110 @@
+package protoenum_test
+
+import (
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// newTaskDescriptor builds the TaskEnum descriptor at runtime, the way a schema registry loads one
+// Carries source info so the value comments become descriptions
+//
+// 在运行时构建 TaskEnum 描述符，与 schema registry 的加载方式一致
+// 携带源码信息，使枚举值注释成为描述
+func newTaskDescriptor(t *testing.T) protoreflect.EnumDescriptor {
+	value := func(name string, number int32) *descriptorpb.EnumValueDescriptorProto {
+		return &descriptorpb.EnumValueDescriptorProto{Name: proto.String(name), Number: proto.Int32(number)}
+	}
+	comment := func(path []int32, leading string, trailing string) *descriptorpb.SourceCodeInfo_Location {
+		return &descriptorpb.SourceCodeInfo_Location{
+			Path:             path,
+			Span:             []int32{0, 0, 0},
+			LeadingComments:  proto.String(leading),
+			TrailingComments: proto.String(trailing),
+		}
+	}
+	fileSet := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+		Name:    proto.String("dynamictask/dynamictask.proto"),
+		Package: proto.String("dynamictask"),
+		Syntax:  proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("TaskEnum"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				value("TASK_ENUM_UNKNOWN", 0),
+				value("TASK_ENUM_RUNNING", 1),
+				value("TASK_ENUM_DONE", 2),
+			},
+		}},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{Location: []*descriptorpb.SourceCodeInfo_Location{
+			comment([]int32{5, 0, 2, 1}, " Task is running\n", ""),
+			comment([]int32{5, 0, 2, 2}, "", " Task is done\n"),
+		}},
+	}}}
+	files, err := protodesc.NewFiles(fileSet)
+	require.NoError(t, err)
+	desc, err := files.FindDescriptorByName("dynamictask.TaskEnum")
+	require.NoError(t, err)
+	return desc.(protoreflect.EnumDescriptor)
+}
+
+// TestNewDynamicEnums tests lookups on a collection built from a runtime descriptor
+//
+// 验证基于运行时描述符构建的集合的查找
+func TestNewDynamicEnums(t *testing.T) {
+	desc := newTaskDescriptor(t)
+	enums := protoenum.NewDynamicEnums(desc, protoenum.NamingLower)
+
+	require.Equal(t, "dynamictask.TaskEnum", enums.FullName())
+	require.Equal(t, []string{"unknown", "running", "done"}, enums.ListBasics())
+	require.Equal(t, "TASK_ENUM_UNKNOWN", enums.GetDefault().Name())
+
+	enum, ok := enums.LookupByName("TASK_ENUM_RUNNING")
+	require.True(t, ok)
+	require.Equal(t, int32(1), enum.Code())
+	require.Equal(t, "Task is running", enum.Meta().Desc())
+	require.Equal(t, "Task is done", enums.GetByBasic("done").Meta().Desc())
+	require.Same(t, enum, enums.GetByProto(protoenum.NewDynamicProto(desc, 1)))
+
+	_, err := enums.ParseByName("TASK_ENUM_STOPPED")
+	require.Error(t, err)
+	t.Log(err)
+
+	unknown, err := enums.GetOrUnknownByCode(9)
+	require.NoError(t, err)
+	require.True(t, unknown.IsUnknown())
+	require.Equal(t, "9", unknown.Proto().String())
+	require.NoError(t, enums.CheckComplete())
+}
+
+// TestNewDynamicEnums_Naming tests the naming strategies reading options off a loaded descriptor
+//
+// 验证命名策略从已加载的描述符中读取选项
+func TestNewDynamicEnums_Naming(t *testing.T) {
+	file, err := protodesc.NewFile(protodesc.ToFileDescriptorProto(protoenumstatus.File_protoenumstatus_protoenumstatus_proto), protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	desc := file.Enums().ByName("StatusEnum")
+
+	enums := protoenum.NewDynamicEnums(desc, protoenum.NamingOptionBasic)
+	require.Equal(t, []string{"unknown", "success", "failure"}, enums.ListBasics())
+	require.Equal(t, "Operation succeeded", enums.GetByBasic("success").Meta().Desc())
+
+	enums = protoenum.NewDynamicEnums(desc, protoenum.NamingName)
+	require.Equal(t, []string{"UNKNOWN", "SUCCESS", "FAILURE"}, enums.ListBasics())
+
+	sameNaming := func(value protoreflect.EnumValueDescriptor) string {
+		return "same"
+	}
+	_, err = protoenum.TryNewDynamicEnums(desc, sameNaming)
+	require.Error(t, err)
+	t.Log(err)
+
+	var conflictError *protoenum.ConflictError
+	require.ErrorAs(t, recoverError(func() { protoenum.NewDynamicEnums(desc, sameNaming) }), &conflictError)
+}
@@ -0,0 +1,170 @@
+package protoenum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoDescriptor is returned when the protoEnum type does not expose a protoreflect.EnumDescriptor
+//
+// 当 protoEnum 类型未提供 protoreflect.EnumDescriptor 时返回 ErrNoDescriptor
+var ErrNoDescriptor = errors.New("protoenum: proto enum has no descriptor")
+
+// ErrFrozen is the panic value raised when mutating an Enums collection after Freeze
+//
+// 在 Freeze 之后修改 Enums 集合时以 ErrFrozen panic
+var ErrFrozen = errors.New("protoenum: enums are frozen")
+
+// ErrNoDefault is returned by a Strict build when no Default has been configured
+//
+// 当 Strict 构建未配置 Default 时返回 ErrNoDefault
+var ErrNoDefault = errors.New("protoenum: strict build requires an explicit default")
+
+// ErrRegistered is returned when registering a second Enums collection under the same full name
+//
+// 当在同一全名下注册第二个 Enums 集合时返回 ErrRegistered
+var ErrRegistered = errors.New("protoenum: enums already registered")
+
+// ErrUnknownNumber is returned when the protoEnum type cannot hold an unknown number, e.g. a struct type
+//
+// 当 protoEnum 类型无法持有未知数字时返回 ErrUnknownNumber，例如结构体类型
+var ErrUnknownNumber = errors.New("protoenum: proto enum type cannot hold unknown numbers")
+
+// ErrOtherEnum is the panic value raised when a descriptor names another enum than the Enums collection
+//
+// 当描述符对应的枚举与 Enums 集合不同时以 ErrOtherEnum panic
+var ErrOtherEnum = errors.New("protoenum: descriptor names another enum")
+
+// ErrEmptyEnums is returned when rendering SQL that needs at least one value from an empty Enums collection
+//
+// 当从空的 Enums 集合渲染至少需要一个值的 SQL 时返回 ErrEmptyEnums
+var ErrEmptyEnums = errors.New("protoenum: enums are empty")
+
+// IncompleteError reports the gaps between an Enums collection and its proto enum descriptor
+// Lists codes declared in the .proto but not registered, and registered codes absent from the .proto
+//
+// IncompleteError 报告 Enums 集合与其 proto 枚举描述符之间的差异
+// 列出 .proto 中声明但未注册的代码，以及已注册但 .proto 中不存在的代码
+type IncompleteError struct {
+	FullName     string   // Full name of the proto enum, e.g. protoenumstatus.StatusEnum // proto 枚举全名
+	MissingCodes []int32  // Codes declared in the descriptor but not registered // 描述符中声明但未注册的代码
+	MissingNames []string // Names of the missing codes, aligned with MissingCodes // 缺失代码对应的名称，与 MissingCodes 一一对应
+	UnknownCodes []int32  // Codes registered but not declared in the descriptor // 已注册但描述符中未声明的代码
+}
+
+// Error describes the missing and unknown codes
+//
+// Error 描述缺失和未知的代码
+func (e *IncompleteError) Error() string {
+	var parts []string
+	if len(e.MissingCodes) > 0 {
+		var items = make([]string, 0, len(e.MissingCodes))
+		for idx, code := range e.MissingCodes {
+			items = append(items, fmt.Sprintf("%s(%d)", e.MissingNames[idx], code))
+		}
+		parts = append(parts, "missing "+strings.Join(items, ", "))
+	}
+	if len(e.UnknownCodes) > 0 {
+		parts = append(parts, fmt.Sprintf("unknown codes %v", e.UnknownCodes))
+	}
+	return fmt.Sprintf("protoenum: enums of %s incomplete: %s", e.FullName, strings.Join(parts, "; "))
+}
+
+// ConflictKind names the identifier that collides when building an Enums collection
+//
+// ConflictKind 表示构建 Enums 集合时发生冲突的标识符类型
+type ConflictKind string
+
+const (
+	ConflictNil   ConflictKind = "nil"   // Enum element is nil // Enum 元素为 nil
+	ConflictProto ConflictKind = "proto" // Duplicate proto enum value // proto 枚举值重复
+	ConflictCode  ConflictKind = "code"  // Duplicate numeric code // 数字代码重复
+	ConflictName  ConflictKind = "name"  // Duplicate name string // 名称字符串重复
+	ConflictBasic ConflictKind = "basic" // Duplicate basic enum value // basic 枚举值重复
+)
+
+// Conflict describes one collision found when building an Enums collection
+//
+// Conflict 描述构建 Enums 集合时发现的一个冲突
+type Conflict struct {
+	Kind  ConflictKind // Identifier that collides // 发生冲突的标识符类型
+	Index int          // Position of the conflicting element in the params // 冲突元素在参数中的位置
+	Prior int          // Position of the earlier element holding the same value, -1 with nil elements // 已持有相同值的先前元素位置，nil 元素时为 -1
+	Value string       // Formatted value that collides // 发生冲突的值的格式化文本
+}
+
+// ConflictError reports each collision found when building an Enums collection in one pass
+// Returned by TryNewEnums so config-driven collections can be validated without panics
+//
+// ConflictError 一次性报告构建 Enums 集合时发现的所有冲突
+// 由 TryNewEnums 返回，使配置驱动的集合无需 panic 即可校验
+type ConflictError struct {
+	Conflicts []Conflict // Each collision in params sequence // 按参数次序排列的各冲突
+}
+
+// Error describes each collision
+//
+// Error 描述各冲突
+func (e *ConflictError) Error() string {
+	var items = make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		if conflict.Kind == ConflictNil {
+			items = append(items, fmt.Sprintf("nil enum at %d", conflict.Index))
+			continue
+		}
+		items = append(items, fmt.Sprintf("duplicate %s %s at %d (prior %d)", conflict.Kind, conflict.Value, conflict.Index, conflict.Prior))
+	}
+	return fmt.Sprintf("protoenum: %d conflicts: %s", len(e.Conflicts), strings.Join(items, "; "))
+}
+
+// LookupKind names the identifier used when looking up an Enum
+//
+// LookupKind 表示查找 Enum 时使用的标识符类型
+type LookupKind string
+
+const (
+	LookupProto LookupKind = "proto" // Lookup using the proto enum value // 按 proto 枚举值查找
+	LookupCode  LookupKind = "code"  // Lookup using the numeric code // 按数字代码查找
+	LookupName  LookupKind = "name"  // Lookup using the name string // 按名称字符串查找
+	LookupBasic LookupKind = "basic" // Lookup using the basic enum value // 按 basic 枚举值查找
+)
+
+// UnknownValueError reports an input that matches no Enum in the collection
+// Carries the accepted codes, names and basic values so API layers can render helpful messages
+// Use errors.As to extract it from errors returned by ParseByXxx
+//
+// UnknownValueError 报告在集合中找不到匹配 Enum 的输入
+// 携带可接受的代码、名称和 basic 值，便于 API 层输出友好的提示
+// 使用 errors.As 从 ParseByXxx 返回的错误中提取
+type UnknownValueError struct {
+	FullName    string     // Full name of the proto enum, e.g. protoenumstatus.StatusEnum // proto 枚举全名
+	Kind        LookupKind // Identifier used in the lookup // 查找时使用的标识符类型
+	Input       string     // Formatted input that matches nothing // 未匹配的输入的格式化文本
+	Codes       []int32    // Accepted codes in defined sequence // 按定义次序排列的可接受代码
+	Names       []string   // Accepted names in defined sequence // 按定义次序排列的可接受名称
+	Basics      []string   // Accepted basic values formatted in defined sequence // 按定义次序排列的可接受 basic 值
+	Suggestions []string   // Closest candidates ranked by Suggest, empty when none is close // 由 Suggest 排序的最接近候选，没有接近的候选时为空
+}
+
+// Error describes the input and the accepted values of the lookup kind
+// Appends the closest candidates when there are any, e.g. did you mean [success]
+//
+// Error 描述输入以及该查找类型下可接受的值
+// 存在最接近的候选时追加提示，例如 did you mean [success]
+func (e *UnknownValueError) Error() string {
+	var expected any
+	switch e.Kind {
+	case LookupCode, LookupProto:
+		expected = e.Codes
+	case LookupBasic:
+		expected = e.Basics
+	default:
+		expected = e.Names
+	}
+	var message = fmt.Sprintf("protoenum: invalid %s %q of %s, expected one of %v", e.Kind, e.Input, e.FullName, expected)
+	if len(e.Suggestions) > 0 {
+		message += fmt.Sprintf(", did you mean %v", e.Suggestions)
+	}
+	return message
+}
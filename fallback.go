@@ -0,0 +1,110 @@
+package protoenum
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+)
+
+// FallbackEvent describes a GetByXxx lookup that missed and returned the default
+//
+// FallbackEvent 描述一次查找失败并返回默认值的 GetByXxx 调用
+type FallbackEvent struct {
+	FullName string     // Full name of the proto enum, e.g. protoenumstatus.StatusEnum // proto 枚举全名
+	Kind     LookupKind // Identifier used in the lookup // 查找时使用的标识符类型
+	Input    string     // Formatted raw input that matches nothing // 未匹配的原始输入的格式化文本
+}
+
+// MissCounts holds the count of fallbacks of each lookup kind since creation
+// The counts only grow, so export them as monotonic counters
+//
+// MissCounts 保存自创建以来各查找类型的回退次数
+// 计数只增不减，因此应作为单调递增的计数器导出
+type MissCounts struct {
+	Proto uint64 // Misses of GetByProto // GetByProto 的未命中次数
+	Code  uint64 // Misses of GetByCode // GetByCode 的未命中次数
+	Name  uint64 // Misses of GetByName // GetByName 的未命中次数
+	Basic uint64 // Misses of GetByBasic // GetByBasic 的未命中次数
+}
+
+// Total returns the sum of the misses of each lookup kind
+//
+// 返回各查找类型未命中次数之和
+func (m MissCounts) Total() uint64 {
+	return m.Proto + m.Code + m.Name + m.Basic
+}
+
+// missCounters counts the fallbacks of each lookup kind with atomic adds
+//
+// missCounters 使用原子加法统计各查找类型的回退次数
+type missCounters struct {
+	proto atomic.Uint64
+	code  atomic.Uint64
+	name  atomic.Uint64
+	basic atomic.Uint64
+}
+
+// WithFallbackHook sets the callback fired whenever GetByXxx misses and returns the default
+// The hook runs on the goroutine of the lookup, keep it fast and safe to call concurrently
+// Panics with ErrFrozen once the collection is frozen
+//
+// 设置 GetByXxx 查找失败并返回默认值时触发的回调
+// 回调在执行查找的 goroutine 上运行，应保持快速且可并发调用
+// 集合冻结后会以 ErrFrozen panic
+//
+// Example:
+//
+//	enums.WithFallbackHook(protoenum.ZapFallbackHook(zap.L()))
+func (c *Enums[P, B, M]) WithFallbackHook(hook func(event FallbackEvent)) *Enums[P, B, M] {
+	c.mustMutable()
+	c.fallbackHook = hook
+	return c
+}
+
+// MissCounts returns the count of fallbacks of each lookup kind since creation
+//
+// 返回自创建以来各查找类型的回退次数
+func (c *Enums[P, B, M]) MissCounts() MissCounts {
+	return MissCounts{
+		Proto: c.missCounters.proto.Load(),
+		Code:  c.missCounters.code.Load(),
+		Name:  c.missCounters.name.Load(),
+		Basic: c.missCounters.basic.Load(),
+	}
+}
+
+// fallback counts the miss, fires the hook and returns the default
+// Panics if no default value has been configured, after counting the miss
+//
+// fallback 统计未命中次数，触发回调并返回默认值
+// 如果未配置默认值，会在统计之后 panic
+func (c *Enums[P, B, M]) fallback(kind LookupKind, input any) *Enum[P, B, M] {
+	switch kind {
+	case LookupProto:
+		c.missCounters.proto.Add(1)
+	case LookupCode:
+		c.missCounters.code.Add(1)
+	case LookupName:
+		c.missCounters.name.Add(1)
+	case LookupBasic:
+		c.missCounters.basic.Add(1)
+	}
+	if c.fallbackHook != nil {
+		c.fallbackHook(FallbackEvent{FullName: c.FullName(), Kind: kind, Input: fmt.Sprint(input)})
+	}
+	return c.GetDefault()
+}
+
+// ZapFallbackHook returns a fallback hook logging each event as a warning with the given logger
+//
+// 返回使用给定 logger 以警告级别记录各事件的回退回调
+func ZapFallbackHook(logger *zap.Logger) func(event FallbackEvent) {
+	return func(event FallbackEvent) {
+		logger.Warn("protoenum: lookup fell back to default",
+			zap.String("enum", event.FullName),
+			zap.String("kind", string(event.Kind)),
+			zap.String("input", event.Input),
+		)
+	}
+}
@@ -0,0 +1,90 @@
+package protoenum_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// TestEnums_WithFallbackHook tests the hook fires with the lookup kind and raw input on each miss
+// Checks hits do not fire the hook
+//
+// 验证每次查找失败时回调都会携带查找类型和原始输入触发
+// 测试查找命中时不会触发回调
+func TestEnums_WithFallbackHook(t *testing.T) {
+	var events []protoenum.FallbackEvent
+	enums := newStatusEnums().WithFallbackHook(func(event protoenum.FallbackEvent) {
+		events = append(events, event)
+	})
+
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enums.GetByCode(1).Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetByCode(9).Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetByName("DONE").Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetByBasic("done").Proto())
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetByProto(protoenumstatus.StatusEnum(7)).Proto())
+
+	require.Equal(t, []protoenum.FallbackEvent{
+		{FullName: "protoenumstatus.StatusEnum", Kind: protoenum.LookupCode, Input: "9"},
+		{FullName: "protoenumstatus.StatusEnum", Kind: protoenum.LookupName, Input: "DONE"},
+		{FullName: "protoenumstatus.StatusEnum", Kind: protoenum.LookupBasic, Input: "done"},
+		{FullName: "protoenumstatus.StatusEnum", Kind: protoenum.LookupProto, Input: "7"},
+	}, events)
+}
+
+// TestEnums_MissCounts tests the miss counters of each lookup kind under concurrent lookups
+//
+// 验证并发查找下各查找类型的未命中计数
+func TestEnums_MissCounts(t *testing.T) {
+	enums := newStatusEnums().Freeze()
+	require.Zero(t, enums.MissCounts().Total())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				enums.GetByCode(9)
+				enums.GetByCode(1)
+				enums.GetByName("DONE")
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, protoenum.MissCounts{Code: 400, Name: 400}, enums.MissCounts())
+	require.Equal(t, uint64(800), enums.MissCounts().Total())
+
+	// Misses without a default are counted before panicking // 无默认值时的未命中在 panic 前计数
+	enums = newStatusEnums().WithUnsetDefault()
+	require.Panics(t, func() { enums.GetByBasic("done") })
+	require.Equal(t, uint64(1), enums.MissCounts().Basic)
+}
+
+// TestZapFallbackHook tests the zap hook logs each fallback as a warning
+//
+// 验证 zap 回调以警告级别记录各次回退
+func TestZapFallbackHook(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	enums, err := protoenum.Build[protoenumstatus.StatusEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "unknown")).
+		Default(protoenumstatus.StatusEnum_UNKNOWN).
+		FallbackHook(protoenum.ZapFallbackHook(zap.New(core))).
+		Build()
+	require.NoError(t, err)
+
+	enums.GetByName("SUCCESS")
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	t.Log(entry.Message, entry.ContextMap())
+	require.Equal(t, map[string]any{
+		"enum":  "protoenumstatus.StatusEnum",
+		"kind":  "name",
+		"input": "SUCCESS",
+	}, entry.ContextMap())
+}
@@ -0,0 +1,35 @@
+package protoenum
+
+// Freeze makes the collection immutable and returns the Enums instance
+// Afterwards SetDefault, SetDefaultValid, UnsetDefault and the WithXxx chain methods panic with ErrFrozen
+// Lookups read the collection without locks, so freeze before sharing it across goroutines
+// Calling Freeze again is a no-op
+//
+// 使集合不可变并返回 Enums 实例
+// 之后 SetDefault、SetDefaultValid、UnsetDefault 以及 WithXxx 链式方法会以 ErrFrozen panic
+// 查找方法不加锁读取集合，因此在跨 goroutine 共享前应先冻结
+// 重复调用 Freeze 不会产生影响
+//
+// Example:
+//
+//	var enums = protoenum.NewEnums(...).WithDefaultValid(true).Freeze()
+func (c *Enums[P, B, M]) Freeze() *Enums[P, B, M] {
+	c.frozenStage.Store(true)
+	return c
+}
+
+// IsFrozen reports whether Freeze has been called
+//
+// 判断是否已调用 Freeze
+func (c *Enums[P, B, M]) IsFrozen() bool {
+	return c.frozenStage.Load()
+}
+
+// mustMutable panics with ErrFrozen when the collection is frozen
+//
+// 当集合已冻结时以 ErrFrozen panic
+func (c *Enums[P, B, M]) mustMutable() {
+	if c.frozenStage.Load() {
+		panic(ErrFrozen)
+	}
+}
@@ -0,0 +1,115 @@
+package protoenum_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_Freeze tests each mutator panics once the collection is frozen
+// Checks the default configuration stays the same after the rejected calls
+//
+// 验证集合冻结后各修改方法都会 panic
+// 测试被拒绝的调用不会改变默认值配置
+func TestEnums_Freeze(t *testing.T) {
+	enums := newStatusEnums().WithDefaultValid(true)
+	require.False(t, enums.IsFrozen())
+	require.Same(t, enums, enums.Freeze())
+	require.True(t, enums.IsFrozen())
+	require.Same(t, enums, enums.Freeze())
+
+	require.Panics(t, func() { enums.UnsetDefault() })
+	require.Panics(t, func() { enums.SetDefault(enums.GetByCode(1)) })
+	require.Panics(t, func() { enums.SetDefaultValid(false) })
+	require.Panics(t, func() { enums.SetDefaultProto(protoenumstatus.StatusEnum_FAILURE) })
+	require.Panics(t, func() { enums.WithUnsetDefault() })
+	require.Panics(t, func() { enums.WithDefaultCode(2) })
+
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetDefaultProto())
+	require.Len(t, enums.ListValidProtos(), 3)
+}
+
+// TestEnums_Freeze_PanicValue tests the panic value is ErrFrozen itself
+// Checks callers can recover and match it with errors.Is
+//
+// 验证 panic 值就是 ErrFrozen 本身
+// 测试调用方可以 recover 并通过 errors.Is 匹配
+func TestEnums_Freeze_PanicValue(t *testing.T) {
+	enums := newStatusEnums().Freeze()
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		err, ok := r.(error)
+		require.True(t, ok)
+		t.Log(err)
+		require.True(t, errors.Is(err, protoenum.ErrFrozen))
+	}()
+	enums.SetDefaultValid(true)
+}
+
+// TestEnums_Freeze_Concurrent hammers lookups while other goroutines attempt mutation
+// Run with -race to check the frozen collection is read without data races
+// Goroutines only record what they observe, the checks run once they have finished
+//
+// 在其他 goroutine 尝试修改的同时并发执行查找
+// 使用 -race 运行以检查冻结的集合读取时没有数据竞争
+// goroutine 只记录观察到的结果，检查在它们结束后进行
+func TestEnums_Freeze_Concurrent(t *testing.T) {
+	enums := newStatusEnums().Freeze()
+
+	// recoverFrozen runs the mutation and reports whether it panicked with ErrFrozen
+	// recoverFrozen 执行修改操作，并报告其是否以 ErrFrozen panic
+	recoverFrozen := func(run func()) (frozen bool) {
+		defer func() {
+			err, ok := recover().(error)
+			frozen = ok && errors.Is(err, protoenum.ErrFrozen)
+		}()
+		run()
+		return false
+	}
+
+	const workers = 8
+	var lookupMisses [workers]int
+	var mutateMisses [workers]int
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func(idx int) {
+			defer wg.Done()
+			for k := 0; k < 1000; k++ {
+				if enums.GetByCode(9).Proto() != protoenumstatus.StatusEnum_UNKNOWN {
+					lookupMisses[idx]++
+				}
+				if enums.GetByName("SUCCESS").Basic() != "success" {
+					lookupMisses[idx]++
+				}
+				if len(enums.ListValidBasics()) != 2 {
+					lookupMisses[idx]++
+				}
+				for range enums.Valid() {
+				}
+			}
+		}(i)
+		go func(idx int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				if !recoverFrozen(func() { enums.UnsetDefault() }) {
+					mutateMisses[idx]++
+				}
+				if !recoverFrozen(func() { enums.SetDefaultValid(true) }) {
+					mutateMisses[idx]++
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, [workers]int{}, lookupMisses)
+	require.Equal(t, [workers]int{}, mutateMisses)
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetDefaultProto())
+}
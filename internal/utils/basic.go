@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// FormatBasic formats a basic enum value as text using its underlying kind
+// Supports string, bool, integer and float kinds, e.g. type StatusType string
+//
+// 根据底层类型将 basic 枚举值格式化为文本
+// 支持 string、bool、整数和浮点类型，例如 type StatusType string
+func FormatBasic[B comparable](basic B) (string, error) {
+	rv := reflect.ValueOf(basic)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported basic kind %s", rv.Kind())
+	}
+}
+
+// ParseBasic parses text into a basic enum value using its underlying kind
+// The reverse of FormatBasic, supports string, bool, integer and float kinds
+//
+// 根据底层类型将文本解析为 basic 枚举值
+// 与 FormatBasic 互逆，支持 string、bool、整数和浮点类型
+func ParseBasic[B comparable](text string) (B, error) {
+	var res B
+	rv := reflect.ValueOf(&res).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return res, err
+		}
+		rv.SetBool(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return res, err
+		}
+		rv.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return res, err
+		}
+		rv.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return res, err
+		}
+		rv.SetFloat(value)
+	default:
+		return res, fmt.Errorf("unsupported basic kind %s", rv.Kind())
+	}
+	return res, nil
+}
+
+// DriverValue converts a basic enum value into a database/sql/driver value
+// Strings stay strings, integers become int64, floats become float64
+// Returns an error when an unsigned value exceeds math.MaxInt64, instead of wrapping to a negative number
+//
+// 将 basic 枚举值转换为 database/sql/driver 值
+// 字符串保持为字符串，整数转换为 int64，浮点数转换为 float64
+// 当无符号值超过 math.MaxInt64 时返回错误，而不是回绕为负数
+func DriverValue[B comparable](basic B) (driver.Value, error) {
+	rv := reflect.ValueOf(basic)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("basic value %d overflows int64", rv.Uint())
+		}
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	default:
+		return nil, fmt.Errorf("unsupported basic kind %s", rv.Kind())
+	}
+}
+
+// DriverText converts a value scanned from database/sql into text
+// Handles the driver value types: string, []byte, int64, float64 and bool
+//
+// 将从 database/sql 扫描得到的值转换为文本
+// 处理各驱动值类型：string、[]byte、int64、float64 和 bool
+func DriverText(src any) (string, error) {
+	switch value := src.(type) {
+	case string:
+		return value, nil
+	case []byte:
+		return string(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	default:
+		return "", fmt.Errorf("unsupported driver value type %T", src)
+	}
+}
@@ -5,6 +5,11 @@
 // 提供用于处理指针创建和值提取的泛型函数
 package utils
 
+import (
+	"strings"
+	"unicode"
+)
+
 // GetValuePointer returns a pointer to the given value
 // Creates a new pointer pointing to a clone of the input value
 //
@@ -35,3 +40,74 @@ func Zero[T any]() T {
 	var zero T
 	return zero
 }
+
+// ScreamingSnake converts a CamelCase name into SCREAMING_SNAKE_CASE, e.g. StatusEnum -> STATUS_ENUM
+// Matches the conventional prefix style of protobuf enum value names
+// Shares the word splitting of NormalizeName, so acronyms stay whole, e.g. HTTPStatus -> HTTP_STATUS
+//
+// 将驼峰名称转换成大写下划线形式，例如 StatusEnum -> STATUS_ENUM
+// 与 protobuf 枚举值名称的约定前缀风格一致
+// 与 NormalizeName 共用分词规则，缩写词保持完整，例如 HTTPStatus -> HTTP_STATUS
+func ScreamingSnake(name string) string {
+	return NormalizeName(name)
+}
+
+// BasicName derives the basic string of an enum value, e.g. SUCCESS -> success, STATUS_ENUM_SUCCESS -> success
+// Strips the conventional ENUM_NAME_ prefix when present, then converts to lower case
+//
+// 推导枚举值的 basic 字符串，例如 SUCCESS -> success，STATUS_ENUM_SUCCESS -> success
+// 存在约定的 ENUM_NAME_ 前缀时先去除，再转换成小写
+func BasicName(enumName string, valueName string) string {
+	if trimmed := strings.TrimPrefix(valueName, ScreamingSnake(enumName)+"_"); trimmed != "" {
+		valueName = trimmed
+	}
+	return strings.ToLower(valueName)
+}
+
+// NormalizeName converts camelCase, PascalCase, kebab-case and snake_case names into SCREAMING_SNAKE_CASE
+// Splits words at case changes and at '-', '_', '.' and ' ', e.g. operationSucceeded -> OPERATION_SUCCEEDED
+//
+// 将 camelCase、PascalCase、kebab-case 和 snake_case 名称转换成大写下划线形式
+// 在大小写变化处以及 '-'、'_'、'.'、' ' 处拆分单词，例如 operationSucceeded -> OPERATION_SUCCEEDED
+func NormalizeName(name string) string {
+	var runes = []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == '_' || r == '.' || r == ' ':
+			sb.WriteByte('_')
+			continue
+		case i > 0 && unicode.IsUpper(r):
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	return sb.String()
+}
+
+// EditDistance returns the Levenshtein distance between two strings counted in runes
+//
+// 返回两个字符串之间以 rune 计数的 Levenshtein 编辑距离
+func EditDistance(a string, b string) int {
+	var ra, rb = []rune(a), []rune(b)
+	var prev = make([]int, len(rb)+1)
+	var curr = make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -1 +1,142 @@
 package utils_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScreamingSnake tests CamelCase names convert into SCREAMING_SNAKE_CASE
+//
+// 验证驼峰名称转换成大写下划线形式
+func TestScreamingSnake(t *testing.T) {
+	require.Equal(t, "STATUS_ENUM", utils.ScreamingSnake("StatusEnum"))
+	require.Equal(t, "RESULT", utils.ScreamingSnake("Result"))
+	require.Equal(t, "HTTP_STATUS", utils.ScreamingSnake("HTTPStatus"))
+	require.Equal(t, "HTTP2_STATUS", utils.ScreamingSnake("Http2Status"))
+}
+
+// TestBasicName tests basic strings strip the conventional enum name prefix
+//
+// 验证 basic 字符串会去除约定的枚举名称前缀
+func TestBasicName(t *testing.T) {
+	require.Equal(t, "success", utils.BasicName("StatusEnum", "SUCCESS"))
+	require.Equal(t, "success", utils.BasicName("StatusEnum", "STATUS_ENUM_SUCCESS"))
+	require.Equal(t, "in_progress", utils.BasicName("StatusEnum", "STATUS_ENUM_IN_PROGRESS"))
+	require.Equal(t, "status_enum_", utils.BasicName("StatusEnum", "STATUS_ENUM_"))
+	require.Equal(t, "ok", utils.BasicName("HTTPStatus", "HTTP_STATUS_OK"))
+	require.Equal(t, "not_found", utils.BasicName("HTTPStatusEnum", "HTTP_STATUS_ENUM_NOT_FOUND"))
+}
+
+// TestNormalizeName tests camelCase, kebab-case and snake_case names convert into SCREAMING_SNAKE_CASE
+//
+// 验证 camelCase、kebab-case 和 snake_case 名称转换成大写下划线形式
+func TestNormalizeName(t *testing.T) {
+	require.Equal(t, "OPERATION_SUCCEEDED", utils.NormalizeName("operationSucceeded"))
+	require.Equal(t, "OPERATION_SUCCEEDED", utils.NormalizeName("OperationSucceeded"))
+	require.Equal(t, "OPERATION_SUCCEEDED", utils.NormalizeName("operation-succeeded"))
+	require.Equal(t, "OPERATION_SUCCEEDED", utils.NormalizeName("operation_succeeded"))
+	require.Equal(t, "STATUS_ENUM_SUCCESS", utils.NormalizeName("STATUS_ENUM_SUCCESS"))
+	require.Equal(t, "HTTP_STATUS", utils.NormalizeName("HTTPStatus"))
+	require.Equal(t, "V2_BETA", utils.NormalizeName("v2Beta"))
+}
+
+// TestEditDistance tests the Levenshtein distance of ASCII and multi-byte strings
+//
+// 验证 ASCII 和多字节字符串的 Levenshtein 编辑距离
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, utils.EditDistance("success", "success"))
+	require.Equal(t, 1, utils.EditDistance("sucess", "success"))
+	require.Equal(t, 2, utils.EditDistance("failrue", "failure"))
+	require.Equal(t, 3, utils.EditDistance("kitten", "sitting"))
+	require.Equal(t, 7, utils.EditDistance("", "success"))
+	require.Equal(t, 1, utils.EditDistance("成功", "成"))
+}
+
+// TestFormatBasic tests basic values of various kinds format into text
+//
+// 验证各种类型的 basic 值格式化为文本
+func TestFormatBasic(t *testing.T) {
+	type StatusType string
+	type LevelType int8
+
+	text, err := utils.FormatBasic(StatusType("success"))
+	require.NoError(t, err)
+	require.Equal(t, "success", text)
+
+	text, err = utils.FormatBasic(LevelType(-3))
+	require.NoError(t, err)
+	require.Equal(t, "-3", text)
+
+	_, err = utils.FormatBasic(struct{ v int }{v: 1})
+	require.Error(t, err)
+}
+
+// TestParseBasic tests text parses back into basic values of various kinds
+//
+// 验证文本解析回各种类型的 basic 值
+func TestParseBasic(t *testing.T) {
+	type StatusType string
+	type LevelType uint16
+
+	status, err := utils.ParseBasic[StatusType]("success")
+	require.NoError(t, err)
+	require.Equal(t, StatusType("success"), status)
+
+	level, err := utils.ParseBasic[LevelType]("7")
+	require.NoError(t, err)
+	require.Equal(t, LevelType(7), level)
+
+	_, err = utils.ParseBasic[LevelType]("x")
+	require.Error(t, err)
+
+	enabled, err := utils.ParseBasic[bool]("true")
+	require.NoError(t, err)
+	require.True(t, enabled)
+}
+
+// TestDriverValue tests basic values convert into driver values
+// Checks unsigned values above math.MaxInt64 return an error instead of wrapping
+//
+// 验证 basic 值转换为驱动值
+// 测试超过 math.MaxInt64 的无符号值返回错误而不是回绕
+func TestDriverValue(t *testing.T) {
+	type StatusType string
+	type LevelType uint8
+	type SizeType uint64
+
+	value, err := utils.DriverValue(StatusType("success"))
+	require.NoError(t, err)
+	require.Equal(t, "success", value)
+
+	value, err = utils.DriverValue(LevelType(2))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), value)
+
+	value, err = utils.DriverValue(SizeType(math.MaxInt64))
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), value)
+
+	_, err = utils.DriverValue(SizeType(math.MaxInt64 + 1))
+	require.Error(t, err)
+	t.Log(err)
+}
+
+// TestDriverText tests scanned driver values convert into text
+//
+// 验证扫描得到的驱动值转换为文本
+func TestDriverText(t *testing.T) {
+	for src, expected := range map[any]string{"a": "a", int64(3): "3", 1.5: "1.5", true: "true"} {
+		text, err := utils.DriverText(src)
+		require.NoError(t, err)
+		require.Equal(t, expected, text)
+	}
+	text, err := utils.DriverText([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, "b", text)
+
+	_, err = utils.DriverText(struct{}{})
+	require.Error(t, err)
+}
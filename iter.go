@@ -0,0 +1,128 @@
+package protoenum
+
+import (
+	"iter"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// All returns an iterator over each Enum in the defined sequence
+// Unlike the ListXxx functions, iterating does not allocate a fresh slice
+//
+// 返回按定义次序遍历各 Enum 的迭代器
+// 与 ListXxx 函数不同，遍历时不会分配新的切片
+func (c *Enums[P, B, M]) All() iter.Seq[*Enum[P, B, M]] {
+	return func(yield func(*Enum[P, B, M]) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Protos returns an iterator over each protoEnum value in the defined sequence
+//
+// 返回按定义次序遍历各 protoEnum 值的迭代器
+func (c *Enums[P, B, M]) Protos() iter.Seq[P] {
+	return func(yield func(P) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item.Proto()) {
+				return
+			}
+		}
+	}
+}
+
+// Basics returns an iterator over each basicEnum value in the defined sequence
+//
+// 返回按定义次序遍历各 basicEnum 值的迭代器
+func (c *Enums[P, B, M]) Basics() iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item.Basic()) {
+				return
+			}
+		}
+	}
+}
+
+// Valid returns an iterator over each Enum excluding the default value
+// Follows the same rules as ListValidProtos, the default is included once SetDefaultValid(true)
+//
+// 返回排除默认值后遍历各 Enum 的迭代器
+// 与 ListValidProtos 规则一致，SetDefaultValid(true) 后包含默认值
+func (c *Enums[P, B, M]) Valid() iter.Seq[*Enum[P, B, M]] {
+	return func(yield func(*Enum[P, B, M]) bool) {
+		for _, item := range c.enumElements {
+			if c.isSkipped(item) {
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// AllByCode returns an iterator over code and Enum pairs in the defined sequence
+//
+// 返回按定义次序遍历代码与 Enum 对的迭代器
+func (c *Enums[P, B, M]) AllByCode() iter.Seq2[int32, *Enum[P, B, M]] {
+	return func(yield func(int32, *Enum[P, B, M]) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item.Code(), item) {
+				return
+			}
+		}
+	}
+}
+
+// ProtosByCode returns an iterator over code and protoEnum pairs in the defined sequence
+//
+// 返回按定义次序遍历代码与 protoEnum 值对的迭代器
+func (c *Enums[P, B, M]) ProtosByCode() iter.Seq2[int32, P] {
+	return func(yield func(int32, P) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item.Code(), item.Proto()) {
+				return
+			}
+		}
+	}
+}
+
+// BasicsByCode returns an iterator over code and basicEnum pairs in the defined sequence
+//
+// 返回按定义次序遍历代码与 basicEnum 值对的迭代器
+func (c *Enums[P, B, M]) BasicsByCode() iter.Seq2[int32, B] {
+	return func(yield func(int32, B) bool) {
+		for _, item := range c.enumElements {
+			if !yield(item.Code(), item.Basic()) {
+				return
+			}
+		}
+	}
+}
+
+// ValidByCode returns an iterator over code and Enum pairs excluding the default value
+//
+// 返回排除默认值后遍历代码与 Enum 对的迭代器
+func (c *Enums[P, B, M]) ValidByCode() iter.Seq2[int32, *Enum[P, B, M]] {
+	return func(yield func(int32, *Enum[P, B, M]) bool) {
+		for _, item := range c.enumElements {
+			if c.isSkipped(item) {
+				continue
+			}
+			if !yield(item.Code(), item) {
+				return
+			}
+		}
+	}
+}
+
+// isSkipped reports whether the item is the default value excluded from the valid sequence
+//
+// 判断该项是否为有效序列中排除的默认值
+func (c *Enums[P, B, M]) isSkipped(item *Enum[P, B, M]) bool {
+	return c.defaultValue != nil && !utils.GetPointerValue(c.defaultValid) && item.Code() == c.defaultValue.Code()
+}
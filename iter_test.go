@@ -0,0 +1,170 @@
+package protoenum_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_Iterators tests the iterators match the ListXxx functions in the defined sequence
+//
+// 验证迭代器与 ListXxx 函数的结果及次序一致
+func TestEnums_Iterators(t *testing.T) {
+	enums := newStatusEnums()
+
+	require.Equal(t, enums.ListProtos(), slices.Collect(enums.Protos()))
+	require.Equal(t, enums.ListBasics(), slices.Collect(enums.Basics()))
+
+	var names []string
+	for enum := range enums.All() {
+		names = append(names, enum.Name())
+	}
+	require.Equal(t, []string{"UNKNOWN", "SUCCESS", "FAILURE"}, names)
+
+	var valid []protoenumstatus.StatusEnum
+	for enum := range enums.Valid() {
+		valid = append(valid, enum.Proto())
+	}
+	require.Equal(t, enums.ListValidProtos(), valid)
+
+	enums.SetDefaultValid(true)
+	require.Len(t, slices.Collect(enums.Valid()), 3)
+}
+
+// TestEnums_Iterators_ByCode tests the iterators keyed by code
+//
+// 验证以代码为键的迭代器
+func TestEnums_Iterators_ByCode(t *testing.T) {
+	enums := newStatusEnums()
+
+	require.Equal(t, map[int32]string{0: "unknown", 1: "success", 2: "failure"}, maps.Collect(enums.BasicsByCode()))
+	require.Equal(t, map[int32]protoenumstatus.StatusEnum{
+		0: protoenumstatus.StatusEnum_UNKNOWN,
+		1: protoenumstatus.StatusEnum_SUCCESS,
+		2: protoenumstatus.StatusEnum_FAILURE,
+	}, maps.Collect(enums.ProtosByCode()))
+
+	var codes []int32
+	for code, enum := range enums.AllByCode() {
+		require.Equal(t, code, enum.Code())
+		codes = append(codes, code)
+	}
+	require.Equal(t, []int32{0, 1, 2}, codes)
+
+	codes = codes[:0]
+	for code := range enums.ValidByCode() {
+		codes = append(codes, code)
+	}
+	require.Equal(t, []int32{1, 2}, codes)
+}
+
+// TestEnums_Iterators_Break tests breaking out of the loop stops the iterators
+//
+// 验证跳出循环会停止迭代器
+func TestEnums_Iterators_Break(t *testing.T) {
+	enums := newStatusEnums()
+
+	var count = 0
+	for range enums.All() {
+		count++
+		break
+	}
+	require.Equal(t, 1, count)
+
+	for code := range enums.AllByCode() {
+		if code == 1 {
+			break
+		}
+		count++
+	}
+	require.Equal(t, 2, count)
+}
+
+// TestEnums_Iterators_Allocs tests ranging over the iterators does not allocate
+//
+// 验证遍历迭代器不会分配内存
+func TestEnums_Iterators_Allocs(t *testing.T) {
+	enums := newStatusEnums()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for range enums.All() {
+		}
+		for range enums.Protos() {
+		}
+		for range enums.Basics() {
+		}
+		for range enums.Valid() {
+		}
+		for range enums.AllByCode() {
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+// BenchmarkEnums_ListProtos benchmarks ranging over the slice copied by ListProtos
+//
+// 测试遍历 ListProtos 复制出的切片的性能
+func BenchmarkEnums_ListProtos(b *testing.B) {
+	enums := newStatusEnums()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, proto := range enums.ListProtos() {
+			_ = proto
+		}
+	}
+}
+
+// BenchmarkEnums_Protos benchmarks ranging over the Protos iterator, compare with ListProtos
+//
+// 测试遍历 Protos 迭代器的性能，与 ListProtos 对比
+func BenchmarkEnums_Protos(b *testing.B) {
+	enums := newStatusEnums()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for proto := range enums.Protos() {
+			_ = proto
+		}
+	}
+}
+
+// BenchmarkEnums_ListValidBasics benchmarks ranging over the slice copied by ListValidBasics
+//
+// 测试遍历 ListValidBasics 复制出的切片的性能
+func BenchmarkEnums_ListValidBasics(b *testing.B) {
+	enums := newStatusEnums()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, basic := range enums.ListValidBasics() {
+			_ = basic
+		}
+	}
+}
+
+// BenchmarkEnums_Valid benchmarks ranging over the Valid iterator, compare with ListValidBasics
+//
+// 测试遍历 Valid 迭代器的性能，与 ListValidBasics 对比
+func BenchmarkEnums_Valid(b *testing.B) {
+	enums := newStatusEnums()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for enum := range enums.Valid() {
+			_ = enum.Basic()
+		}
+	}
+}
+
+// BenchmarkEnums_AllByCode benchmarks ranging over the AllByCode iterator
+//
+// 测试遍历 AllByCode 迭代器的性能
+func BenchmarkEnums_AllByCode(b *testing.B) {
+	enums := newStatusEnums()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for code, enum := range enums.AllByCode() {
+			_, _ = code, enum
+		}
+	}
+}
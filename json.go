@@ -0,0 +1,155 @@
+package protoenum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONFormat names the JSON shape used when marshaling an Enum
+//
+// JSONFormat 表示序列化 Enum 时使用的 JSON 形式
+type JSONFormat string
+
+const (
+	JSONBasic  JSONFormat = "basic"  // Basic enum value, e.g. "success" // basic 枚举值，例如 "success"
+	JSONName   JSONFormat = "name"   // Name string, e.g. "SUCCESS" // 名称字符串，例如 "SUCCESS"
+	JSONCode   JSONFormat = "code"   // Numeric code, e.g. 1 // 数字代码，例如 1
+	JSONObject JSONFormat = "object" // Object with code, name, basic and desc // 包含 code、name、basic 和 desc 的对象
+)
+
+// describer is implemented by metadata types holding a description, such as MetaDesc
+//
+// describer 由带有描述的元数据类型实现，例如 MetaDesc
+type describer interface {
+	Desc() string
+}
+
+// enumObject is the JSON object shape of an Enum
+//
+// enumObject 是 Enum 的 JSON 对象形式
+type enumObject[B comparable] struct {
+	Code  int32  `json:"code"`
+	Name  string `json:"name"`
+	Basic B      `json:"basic"`
+	Desc  string `json:"desc,omitempty"`
+}
+
+// MarshalJSON emits the basic value of the enum, e.g. "success"
+// Enables embedding *Enum in response structs without custom adapters
+// Unknown enums hold no basic value, so they emit the number instead, e.g. 7, see ParseJSONOrUnknown
+//
+// 输出枚举的 basic 值，例如 "success"
+// 使 *Enum 可直接嵌入响应结构体，无需自定义适配器
+// 未知枚举没有 basic 值，因此改为输出数字，例如 7，参见 ParseJSONOrUnknown
+func (c *Enum[protoEnum, basicEnum, metaType]) MarshalJSON() ([]byte, error) {
+	return c.MarshalJSONFormat(JSONBasic)
+}
+
+// MarshalJSONFormat emits the enum in the given JSON shape
+// The object shape includes desc when the metadata exposes Desc(), e.g. MetaDesc
+// Unknown enums emit the number in the basic shape, the same as MarshalJSON
+//
+// 以给定的 JSON 形式输出枚举
+// 当元数据提供 Desc() 时（如 MetaDesc），对象形式会包含 desc
+// 未知枚举在 basic 形式下输出数字，与 MarshalJSON 一致
+func (c *Enum[protoEnum, basicEnum, metaType]) MarshalJSONFormat(format JSONFormat) ([]byte, error) {
+	switch format {
+	case JSONBasic:
+		if c.unknown {
+			return json.Marshal(c.Code())
+		}
+		return json.Marshal(c.basic)
+	case JSONName:
+		return json.Marshal(c.Name())
+	case JSONCode:
+		return json.Marshal(c.Code())
+	case JSONObject:
+		object := enumObject[basicEnum]{Code: c.Code(), Name: c.Name(), Basic: c.basic}
+		if meta, ok := any(c.meta).(describer); ok {
+			object.Desc = meta.Desc()
+		}
+		return json.Marshal(object)
+	default:
+		return nil, fmt.Errorf("protoenum: unknown json format %q", format)
+	}
+}
+
+// JSON returns a json.Marshaler emitting the enum in the given JSON shape
+// Use this as a response struct field when the basic value is not the wanted shape
+//
+// 返回以给定 JSON 形式输出枚举的 json.Marshaler
+// 当 basic 值不是期望的形式时，可将其作为响应结构体字段使用
+func (c *Enum[protoEnum, basicEnum, metaType]) JSON(format JSONFormat) json.Marshaler {
+	return &enumJSON[protoEnum, basicEnum, metaType]{enum: c, format: format}
+}
+
+// enumJSON binds an Enum with a JSON shape
+//
+// enumJSON 将 Enum 与 JSON 形式绑定
+type enumJSON[P ProtoEnum, B comparable, M any] struct {
+	enum   *Enum[P, B, M]
+	format JSONFormat
+}
+
+// MarshalJSON emits the bound enum in the bound JSON shape
+//
+// 以绑定的 JSON 形式输出绑定的枚举
+func (c *enumJSON[P, B, M]) MarshalJSON() ([]byte, error) {
+	return c.enum.MarshalJSONFormat(c.format)
+}
+
+// ParseJSON finds the Enum matching JSON data in the given shape
+// Accepts the output of MarshalJSONFormat, the object shape is resolved using its code
+// Returns *UnknownValueError when the data matches no enum, including JSON null
+//
+// 查找与给定形式 JSON 数据匹配的 Enum
+// 接受 MarshalJSONFormat 的输出，对象形式按其代码解析
+// 当数据（包括 JSON null）未匹配任何枚举时返回 *UnknownValueError
+func (c *Enums[P, B, M]) ParseJSON(data []byte, format JSONFormat) (*Enum[P, B, M], error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, c.newUnknownValueError(jsonLookupKind(format), "null")
+	}
+	switch format {
+	case JSONBasic:
+		var basic B
+		if err := json.Unmarshal(data, &basic); err != nil {
+			return nil, err
+		}
+		return c.ParseByBasic(basic)
+	case JSONName:
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return nil, err
+		}
+		return c.ParseByName(name)
+	case JSONCode:
+		var code int32
+		if err := json.Unmarshal(data, &code); err != nil {
+			return nil, err
+		}
+		return c.ParseByCode(code)
+	case JSONObject:
+		var object enumObject[B]
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, err
+		}
+		return c.ParseByCode(object.Code)
+	default:
+		return nil, fmt.Errorf("protoenum: unknown json format %q", format)
+	}
+}
+
+// jsonLookupKind returns the lookup kind matching the JSON shape
+//
+// 返回与 JSON 形式对应的查找类型
+func jsonLookupKind(format JSONFormat) LookupKind {
+	switch format {
+	case JSONName:
+		return LookupName
+	case JSONCode, JSONObject:
+		return LookupCode
+	default:
+		return LookupBasic
+	}
+}
@@ -0,0 +1,123 @@
+package protoenum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnum_MarshalJSON tests an embedded *Enum emits the basic value
+//
+// 验证嵌入的 *Enum 输出 basic 值
+func TestEnum_MarshalJSON(t *testing.T) {
+	enums := newStatusEnums()
+
+	type Response struct {
+		Status *protoenum.Enum[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc] `json:"status"`
+	}
+
+	data, err := json.Marshal(&Response{Status: enums.GetByCode(1)})
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.JSONEq(t, `{"status":"success"}`, string(data))
+}
+
+// TestEnum_MarshalJSONFormat tests each JSON shape round-trips through ParseJSON
+// Checks basic, name, code and object shapes using each StatusEnum value
+//
+// 验证各 JSON 形式都能通过 ParseJSON 往返
+// 使用各 StatusEnum 值测试 basic、name、code 和 object 形式
+func TestEnum_MarshalJSONFormat(t *testing.T) {
+	enums := newStatusEnums()
+
+	for _, format := range []protoenum.JSONFormat{protoenum.JSONBasic, protoenum.JSONName, protoenum.JSONCode, protoenum.JSONObject} {
+		t.Run(string(format), func(t *testing.T) {
+			for _, proto := range enums.ListProtos() {
+				enum := enums.GetByProto(proto)
+
+				data, err := enum.MarshalJSONFormat(format)
+				require.NoError(t, err)
+				t.Log(string(data))
+
+				res, err := enums.ParseJSON(data, format)
+				require.NoError(t, err)
+				require.Equal(t, enum, res)
+			}
+		})
+	}
+}
+
+// TestEnum_MarshalJSONFormat_Output tests the exact output of each JSON shape
+//
+// 验证各 JSON 形式的具体输出
+func TestEnum_MarshalJSONFormat_Output(t *testing.T) {
+	enum := newStatusEnums().GetByCode(int32(protoenumstatus.StatusEnum_FAILURE))
+
+	data, err := enum.MarshalJSONFormat(protoenum.JSONBasic)
+	require.NoError(t, err)
+	require.Equal(t, `"failure"`, string(data))
+
+	data, err = enum.MarshalJSONFormat(protoenum.JSONName)
+	require.NoError(t, err)
+	require.Equal(t, `"FAILURE"`, string(data))
+
+	data, err = enum.MarshalJSONFormat(protoenum.JSONCode)
+	require.NoError(t, err)
+	require.Equal(t, `2`, string(data))
+
+	data, err = enum.MarshalJSONFormat(protoenum.JSONObject)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"code":2,"name":"FAILURE","basic":"failure","desc":"失败"}`, string(data))
+
+	_, err = enum.MarshalJSONFormat("xml")
+	require.Error(t, err)
+}
+
+// TestEnum_JSON tests the JSON view used as a response struct field
+// Checks the object shape omits desc when metadata has no description
+//
+// 验证作为响应结构体字段使用的 JSON 视图
+// 测试元数据没有描述时对象形式省略 desc
+func TestEnum_JSON(t *testing.T) {
+	enum := protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success")
+
+	type Response struct {
+		Name   json.Marshaler `json:"name"`
+		Object json.Marshaler `json:"object"`
+	}
+
+	data, err := json.Marshal(&Response{
+		Name:   enum.JSON(protoenum.JSONName),
+		Object: enum.JSON(protoenum.JSONObject),
+	})
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.JSONEq(t, `{"name":"SUCCESS","object":{"code":1,"name":"SUCCESS","basic":"success"}}`, string(data))
+}
+
+// TestEnums_ParseJSON_Unknown tests unknown values and null return UnknownValueError
+//
+// 验证未知值和 null 返回 UnknownValueError
+func TestEnums_ParseJSON_Unknown(t *testing.T) {
+	enums := newStatusEnums()
+
+	_, err := enums.ParseJSON([]byte(`"done"`), protoenum.JSONBasic)
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, "done", unknownValueError.Input)
+
+	_, err = enums.ParseJSON([]byte(`9`), protoenum.JSONCode)
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, protoenum.LookupCode, unknownValueError.Kind)
+
+	_, err = enums.ParseJSON([]byte(`null`), protoenum.JSONName)
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, "null", unknownValueError.Input)
+
+	_, err = enums.ParseJSON([]byte(`{`), protoenum.JSONObject)
+	require.Error(t, err)
+}
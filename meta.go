@@ -6,9 +6,23 @@ package protoenum
 type MetaNone struct{}
 
 // MetaDesc represents metadata with string description attached to enums
+// Also holds the key-value entries declared through the (protoenum.meta) option
 //
 // MetaDesc 代表带字符串描述的枚举元数据
-type MetaDesc struct{ description string }
+// 同时保存通过 (protoenum.meta) 选项声明的键值条目
+type MetaDesc struct {
+	description string
+	attributes  map[string]string
+}
+
+// NewMetaDesc creates MetaDesc with description and key-value metadata entries
+// Used by generated code when values declare the (protoenum.meta) option
+//
+// 使用描述和键值元数据条目创建 MetaDesc
+// 当枚举值声明了 (protoenum.meta) 选项时由生成代码使用
+func NewMetaDesc(description string, attributes map[string]string) *MetaDesc {
+	return &MetaDesc{description: description, attributes: attributes}
+}
 
 // Desc returns the custom description of the enum
 // Provides human-readable description with documentation purposes
@@ -18,3 +32,12 @@ type MetaDesc struct{ description string }
 func (c *MetaDesc) Desc() string {
 	return c.description
 }
+
+// Meta returns the metadata entry stored under the key
+// Returns blank string when the key is not declared
+//
+// 返回该键下保存的元数据条目
+// 未声明该键时返回空字符串
+func (c *MetaDesc) Meta(key string) string {
+	return c.attributes[key]
+}
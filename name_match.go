@@ -0,0 +1,125 @@
+package protoenum
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// NameMatch selects the relaxed name-matching modes of LookupByName, combine modes with |
+// Exact names always match, the modes add matches on top of them
+//
+// NameMatch 选择 LookupByName 的宽松名称匹配模式，可使用 | 组合多个模式
+// 精确名称总是能匹配，这些模式在其基础上增加匹配
+type NameMatch uint8
+
+const (
+	NameMatchCaseFold  NameMatch = 1 << iota // Ignore case, e.g. success and Success match SUCCESS // 忽略大小写，例如 success 和 Success 匹配 SUCCESS
+	NameMatchPrefix                          // Strip the ENUM_NAME_ prefix derived from the descriptor, e.g. STATUS_ENUM_SUCCESS matches SUCCESS // 去除由描述符推导的 ENUM_NAME_ 前缀，例如 STATUS_ENUM_SUCCESS 匹配 SUCCESS
+	NameMatchNormalize                       // Split camelCase and kebab-case words, folding case too, e.g. inProgress matches IN_PROGRESS // 拆分 camelCase 和 kebab-case 单词并忽略大小写，例如 inProgress 匹配 IN_PROGRESS
+
+	NameMatchAll = NameMatchCaseFold | NameMatchPrefix | NameMatchNormalize // Each mode above // 以上全部模式
+)
+
+// WithNameMatch enables the given name-matching modes and returns the Enums instance
+// Precomputes an index of the relaxed names and alias names, so lookups stay O(1)
+// Panics with *ConflictError when two names become the same under the modes
+// Panics with ErrFrozen once the collection is frozen
+//
+// 启用给定的名称匹配模式并返回 Enums 实例
+// 预先计算宽松名称及别名名称的索引，使查找保持 O(1)
+// 当两个名称在这些模式下变得相同时以 *ConflictError panic
+// 集合冻结后会以 ErrFrozen panic
+//
+// Example:
+//
+//	enums.WithNameMatch(protoenum.NameMatchCaseFold | protoenum.NameMatchPrefix)
+//	enums.GetByName("status_enum_success") // SUCCESS
+func (c *Enums[P, B, M]) WithNameMatch(match NameMatch) *Enums[P, B, M] {
+	c.mustMutable()
+	if err := c.setNameMatch(match); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// setNameMatch builds the relaxed name index, returns *ConflictError when two names collide
+//
+// setNameMatch 构建宽松名称索引，两个名称冲突时返回 *ConflictError
+func (c *Enums[P, B, M]) setNameMatch(match NameMatch) error {
+	c.nameMatches = match
+	c.namePrefix = ""
+	if c.descriptor != nil {
+		c.namePrefix = utils.ScreamingSnake(string(c.descriptor.Name())) + "_"
+	}
+	if match == 0 {
+		c.mapNameFold = nil
+		return nil
+	}
+
+	var conflicts []Conflict
+	var mapNameFold = make(map[string]*Enum[P, B, M], len(c.enumElements))
+	var positions = make(map[string]int, len(c.enumElements))
+	for idx, item := range c.enumElements {
+		key := c.nameKey(item.Name())
+		if prior, ok := positions[key]; ok {
+			conflicts = append(conflicts, Conflict{Kind: ConflictName, Index: idx, Prior: prior, Value: key})
+			continue
+		}
+		positions[key] = idx
+		mapNameFold[key] = item
+	}
+	for _, name := range c.aliasNames {
+		key, item := c.nameKey(name), c.mapName2Enum[name]
+		if prior, ok := mapNameFold[key]; ok {
+			if prior != item {
+				conflicts = append(conflicts, Conflict{Kind: ConflictName, Index: slices.Index(c.enumElements, item), Prior: positions[key], Value: key})
+			}
+			continue
+		}
+		positions[key] = slices.Index(c.enumElements, item)
+		mapNameFold[key] = item
+	}
+	if len(conflicts) > 0 {
+		c.nameMatches = 0
+		c.mapNameFold = nil
+		return &ConflictError{Conflicts: conflicts}
+	}
+	c.mapNameFold = mapNameFold
+	return nil
+}
+
+// nameKey converts a name into its index key under the enabled modes
+//
+// nameKey 按已启用的模式将名称转换为索引键
+func (c *Enums[P, B, M]) nameKey(name string) string {
+	if c.nameMatches&NameMatchNormalize != 0 {
+		name = utils.NormalizeName(name)
+	} else if c.nameMatches&NameMatchCaseFold != 0 {
+		name = strings.ToUpper(name)
+	}
+	if c.nameMatches&NameMatchPrefix != 0 && c.namePrefix != "" {
+		if trimmed := strings.TrimPrefix(name, c.namePrefix); trimmed != "" {
+			name = trimmed
+		}
+	}
+	return name
+}
+
+// findByName finds an Enum using its exact name, then using the relaxed name index when enabled
+// Fires the deprecated hook when the found Enum is deprecated
+//
+// 先按精确名称查找 Enum，启用宽松匹配时再使用宽松名称索引
+// 找到的 Enum 已弃用时触发弃用回调
+func (c *Enums[P, B, M]) findByName(name string) (*Enum[P, B, M], bool) {
+	res, ok := c.mapName2Enum[name]
+	if !ok && c.mapNameFold != nil {
+		res, ok = c.mapNameFold[c.nameKey(name)]
+	}
+	if !ok {
+		return nil, false
+	}
+	c.noticeDeprecated(LookupName, res)
+	return res, true
+}
@@ -0,0 +1,121 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wordEnum is a ProtoEnum without protobuf descriptor whose names hold several words
+// wordEnum 是没有 protobuf 描述符且名称包含多个单词的 ProtoEnum
+type wordEnum int32
+
+func (x wordEnum) String() string {
+	return [...]string{"NOT_STARTED", "IN_PROGRESS", "DONE"}[x]
+}
+func (x wordEnum) Number() protoreflect.EnumNumber { return protoreflect.EnumNumber(x) }
+
+// TestEnums_WithNameMatch tests each mode matches the relaxed names
+// Checks exact names keep matching and unknown names still miss
+//
+// 验证各模式都能匹配宽松名称
+// 测试精确名称仍可匹配，未知名称仍然查找失败
+func TestEnums_WithNameMatch(t *testing.T) {
+	testCases := []struct {
+		match protoenum.NameMatch
+		hits  []string
+		miss  []string
+	}{
+		{match: protoenum.NameMatchCaseFold, hits: []string{"SUCCESS", "success", "Success"}, miss: []string{"STATUS_ENUM_SUCCESS"}},
+		{match: protoenum.NameMatchPrefix, hits: []string{"SUCCESS", "STATUS_ENUM_SUCCESS"}, miss: []string{"success", "status_enum_success"}},
+		{match: protoenum.NameMatchCaseFold | protoenum.NameMatchPrefix, hits: []string{"success", "STATUS_ENUM_SUCCESS", "status_enum_success"}, miss: []string{"statusEnumSuccess"}},
+		{match: protoenum.NameMatchAll, hits: []string{"success", "status_enum_success", "statusEnumSuccess", "status-enum-success"}, miss: []string{"succeeded", "STATUS_ENUM_"}},
+	}
+	for _, tc := range testCases {
+		enums := newStatusEnums().WithNameMatch(tc.match)
+		for _, name := range tc.hits {
+			enum, ok := enums.LookupByName(name)
+			require.True(t, ok, name)
+			require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enum.Proto())
+		}
+		for _, name := range tc.miss {
+			_, ok := enums.LookupByName(name)
+			require.False(t, ok, name)
+		}
+	}
+}
+
+// TestEnums_WithNameMatch_Normalize tests camelCase and kebab-case names match multi-word names
+//
+// 验证 camelCase 和 kebab-case 名称匹配多单词名称
+func TestEnums_WithNameMatch_Normalize(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(wordEnum(0), "not_started"),
+		protoenum.NewEnum(wordEnum(1), "in_progress"),
+		protoenum.NewEnum(wordEnum(2), "done"),
+	).WithNameMatch(protoenum.NameMatchNormalize)
+
+	for _, name := range []string{"IN_PROGRESS", "inProgress", "InProgress", "in-progress", "in_progress"} {
+		require.Equal(t, wordEnum(1), enums.MustGetByName(name).Proto())
+	}
+	enum, err := enums.ParseByName("notStarted")
+	require.NoError(t, err)
+	require.Equal(t, wordEnum(0), enum.Proto())
+
+	_, err = enums.ParseByName("inprogress")
+	require.Error(t, err)
+	require.Equal(t, wordEnum(0), enums.GetByName("inprogress").Proto())
+}
+
+// mixedEnum is a ProtoEnum whose names only differ in case
+// mixedEnum 是名称仅大小写不同的 ProtoEnum
+type mixedEnum int32
+
+func (x mixedEnum) String() string                  { return [...]string{"DONE", "Done"}[x] }
+func (x mixedEnum) Number() protoreflect.EnumNumber { return protoreflect.EnumNumber(x) }
+
+// TestEnums_WithNameMatch_Conflict tests names colliding under the modes are rejected
+// Checks the builder reports the collision and frozen collections reject the setting
+//
+// 验证在这些模式下发生冲突的名称会被拒绝
+// 测试构建器报告冲突，冻结的集合拒绝该设置
+func TestEnums_WithNameMatch_Conflict(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(mixedEnum(0), "upper"),
+		protoenum.NewEnum(mixedEnum(1), "title"),
+	)
+	require.NotPanics(t, func() { enums.WithNameMatch(protoenum.NameMatchPrefix) })
+	var panicError *protoenum.ConflictError
+	require.ErrorAs(t, recoverError(func() { enums.WithNameMatch(protoenum.NameMatchCaseFold) }), &panicError)
+	require.Equal(t, "title", enums.GetByName("Done").Basic())
+	require.Equal(t, "upper", enums.GetByName("done").Basic())
+
+	_, err := protoenum.Build[mixedEnum, string, *protoenum.MetaNone]().
+		Add(protoenum.NewEnum(mixedEnum(0), "upper")).
+		Add(protoenum.NewEnum(mixedEnum(1), "title")).
+		NameMatch(protoenum.NameMatchCaseFold).
+		Build()
+	require.Error(t, err)
+	t.Log(err)
+	var conflictError *protoenum.ConflictError
+	require.True(t, errors.As(err, &conflictError))
+	require.Equal(t, protoenum.Conflict{Kind: protoenum.ConflictName, Index: 1, Prior: 0, Value: "DONE"}, conflictError.Conflicts[0])
+
+	frozen := newStatusEnums().Freeze()
+	require.ErrorIs(t, recoverError(func() { frozen.WithNameMatch(protoenum.NameMatchCaseFold) }), protoenum.ErrFrozen)
+}
+
+// recoverError runs the func and returns the error it panics with, nil when it does not panic with an error
+//
+// recoverError 执行该函数并返回其 panic 时携带的错误，未以错误 panic 时返回 nil
+func recoverError(run func()) (err error) {
+	defer func() {
+		err, _ = recover().(error)
+	}()
+	run()
+	return nil
+}
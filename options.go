@@ -0,0 +1,84 @@
+package protoenum
+
+import (
+	"strings"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+	protoenumoptions "github.com/go-xlan/protoenum/protos/protoenum"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OptionBasic returns the (protoenum.basic) option declared on the enum value
+// Returns blank string when the option is not declared
+//
+// 返回枚举值上声明的 (protoenum.basic) 选项
+// 未声明该选项时返回空字符串
+func OptionBasic(value protoreflect.EnumValueDescriptor) string {
+	return getOptionString(value, protoenumoptions.E_Basic)
+}
+
+// OptionDesc returns the (protoenum.desc) option declared on the enum value
+// Returns blank string when the option is not declared
+//
+// 返回枚举值上声明的 (protoenum.desc) 选项
+// 未声明该选项时返回空字符串
+func OptionDesc(value protoreflect.EnumValueDescriptor) string {
+	return getOptionString(value, protoenumoptions.E_Desc)
+}
+
+// OptionMeta returns the (protoenum.meta) entries declared on the enum value as a key-value map
+// Each entry is split at its first '=', e.g. "color=green" -> color: green, an entry without '=' maps to blank
+// Returns nil when the option is not declared
+//
+// 以键值映射返回枚举值上声明的 (protoenum.meta) 条目
+// 每个条目在第一个 '=' 处拆分，例如 "color=green" -> color: green，不含 '=' 的条目映射为空字符串
+// 未声明该选项时返回 nil
+func OptionMeta(value protoreflect.EnumValueDescriptor) map[string]string {
+	options := value.Options()
+	if options == nil || !proto.HasExtension(options, protoenumoptions.E_Meta) {
+		return nil
+	}
+	entries, _ := proto.GetExtension(options, protoenumoptions.E_Meta).([]string)
+	var res = make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, val, _ := strings.Cut(entry, "=")
+		res[key] = val
+	}
+	return res
+}
+
+// getOptionString reads a string extension off the options of the enum value
+//
+// 从枚举值的选项中读取字符串扩展
+func getOptionString(value protoreflect.EnumValueDescriptor, extension protoreflect.ExtensionType) string {
+	options := value.Options()
+	if options == nil || !proto.HasExtension(options, extension) {
+		return ""
+	}
+	res, _ := proto.GetExtension(options, extension).(string)
+	return res
+}
+
+// NewEnumsFromOptions creates an Enums collection driven by the options declared in the .proto
+// Reads (protoenum.basic), (protoenum.desc) and (protoenum.meta) off each value of P's descriptor
+// Values without (protoenum.basic) fall back to the lower-case name, e.g. STATUS_ENUM_SUCCESS -> success
+// The zero-numbered value becomes the default, matching NewEnumsFromDescriptor
+// Aliases declared with allow_alias keep their own (protoenum.basic), which resolves to the first declared name
+//
+// 根据 .proto 中声明的选项创建 Enums 集合
+// 从 P 的描述符中逐个读取枚举值的 (protoenum.basic)、(protoenum.desc) 和 (protoenum.meta)
+// 未声明 (protoenum.basic) 的枚举值回退为小写名称，例如 STATUS_ENUM_SUCCESS -> success
+// 与 NewEnumsFromDescriptor 一致，数字为零的枚举值成为默认值
+// 通过 allow_alias 声明的别名保留各自的 (protoenum.basic)，并解析到首个声明的名称
+func NewEnumsFromOptions[P ProtoEnum]() *Enums[P, string, *MetaDesc] {
+	desc := mustEnumDescriptor[P]()
+	return newEnumsFromValues(func(_ P, value protoreflect.EnumValueDescriptor) string {
+		if basic := OptionBasic(value); basic != "" {
+			return basic
+		}
+		return utils.BasicName(string(desc.Name()), string(value.Name()))
+	}, func(_ P, value protoreflect.EnumValueDescriptor) *MetaDesc {
+		return NewMetaDesc(describeValue(value, OptionDesc(value)), OptionMeta(value))
+	})
+}
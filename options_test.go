@@ -0,0 +1,94 @@
+package protoenum_test
+
+import (
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOptionBasic tests reading the (protoenum.basic) option off enum value descriptors
+// Checks each annotated value exposes the declared basic string
+//
+// 验证从枚举值描述符读取 (protoenum.basic) 选项
+// 测试每个带注解的枚举值都能返回声明的 basic 字符串
+func TestOptionBasic(t *testing.T) {
+	values := protoenumstatus.StatusEnum_UNKNOWN.Descriptor().Values()
+	require.Equal(t, "unknown", protoenum.OptionBasic(values.ByNumber(0)))
+	require.Equal(t, "success", protoenum.OptionBasic(values.ByNumber(1)))
+	require.Equal(t, "failure", protoenum.OptionBasic(values.ByNumber(2)))
+}
+
+// TestOptionDesc tests reading the (protoenum.desc) option off enum value descriptors
+// Checks each annotated value exposes the declared description
+//
+// 验证从枚举值描述符读取 (protoenum.desc) 选项
+// 测试每个带注解的枚举值都能返回声明的描述
+func TestOptionDesc(t *testing.T) {
+	values := protoenumresult.ResultEnum_UNKNOWN.Descriptor().Values()
+	require.Equal(t, "Result unknown", protoenum.OptionDesc(values.ByNumber(0)))
+	require.Equal(t, "Check passed", protoenum.OptionDesc(values.ByNumber(1)))
+	require.Equal(t, "Check missed", protoenum.OptionDesc(values.ByNumber(2)))
+	require.Equal(t, "Check skipped", protoenum.OptionDesc(values.ByNumber(3)))
+}
+
+// TestOptionMeta tests reading the (protoenum.meta) option off enum value descriptors
+// Checks annotated values expose key-value entries and plain values expose nil
+//
+// 验证从枚举值描述符读取 (protoenum.meta) 选项
+// 测试带注解的枚举值返回键值条目，未注解的枚举值返回 nil
+func TestOptionMeta(t *testing.T) {
+	values := protoenumresult.ResultEnum_UNKNOWN.Descriptor().Values()
+	require.Nil(t, protoenum.OptionMeta(values.ByNumber(0)))
+	require.Equal(t, map[string]string{"color": "green"}, protoenum.OptionMeta(values.ByNumber(1)))
+	require.Equal(t, map[string]string{"color": "red"}, protoenum.OptionMeta(values.ByNumber(2)))
+	require.Equal(t, map[string]string{"color": "gray"}, protoenum.OptionMeta(values.ByNumber(3)))
+}
+
+// TestNewEnumsFromOptions_Status tests building StatusEnum collection from proto options
+// Checks basic values, descriptions and default come from the .proto annotations
+//
+// 验证根据 proto 选项构建 StatusEnum 集合
+// 测试 basic 值、描述以及默认值均来自 .proto 注解
+func TestNewEnumsFromOptions_Status(t *testing.T) {
+	enums := protoenum.NewEnumsFromOptions[protoenumstatus.StatusEnum]()
+
+	require.Equal(t, []string{"unknown", "success", "failure"}, enums.ListBasics())
+	require.Equal(t, []protoenumstatus.StatusEnum{
+		protoenumstatus.StatusEnum_UNKNOWN,
+		protoenumstatus.StatusEnum_SUCCESS,
+		protoenumstatus.StatusEnum_FAILURE,
+	}, enums.ListProtos())
+
+	enum := enums.GetByBasic("success")
+	t.Log(enum.Name(), enum.Basic(), enum.Meta().Desc())
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enum.Proto())
+	require.Equal(t, "Operation succeeded", enum.Meta().Desc())
+
+	require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, enums.GetDefaultProto())
+	require.Equal(t, "Status unknown", enums.GetDefault().Meta().Desc())
+}
+
+// TestNewEnumsFromOptions_Result tests building ResultEnum collection from proto options
+// Checks lookups using code and name resolve the annotated values
+//
+// 验证根据 proto 选项构建 ResultEnum 集合
+// 测试按代码和名称查找能得到带注解的枚举值
+func TestNewEnumsFromOptions_Result(t *testing.T) {
+	enums := protoenum.NewEnumsFromOptions[protoenumresult.ResultEnum]()
+
+	require.Equal(t, []string{"pass", "miss", "skip"}, enums.ListValidBasics())
+
+	skip := enums.GetByCode(int32(protoenumresult.ResultEnum_SKIP))
+	require.Equal(t, "skip", skip.Basic())
+	require.Equal(t, "Check skipped", skip.Meta().Desc())
+	require.Equal(t, "gray", skip.Meta().Meta("color"))
+
+	miss := enums.GetByName("MISS")
+	require.Equal(t, "miss", miss.Basic())
+	require.Equal(t, "Check missed", miss.Meta().Desc())
+	require.Equal(t, "red", miss.Meta().Meta("color"))
+	require.Equal(t, "", enums.GetDefault().Meta().Meta("color"))
+}
@@ -0,0 +1,65 @@
+package protoenum
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseByCode finds an Enum using its numeric code
+// Returns *UnknownValueError listing the accepted values if no enum with the given code exists
+//
+// 通过数字代码解析 Enum
+// 如果不存在具有给定代码的枚举则返回列出可接受值的 *UnknownValueError
+func (c *Enums[P, B, M]) ParseByCode(code int32) (*Enum[P, B, M], error) {
+	if res, ok := c.LookupByCode(code); ok {
+		return res, nil
+	}
+	return nil, c.newUnknownValueError(LookupCode, strconv.Itoa(int(code)))
+}
+
+// ParseByName finds an Enum using its string name
+// Returns *UnknownValueError listing the accepted values if no enum with the given name exists
+//
+// 通过字符串名称解析 Enum
+// 如果不存在具有给定名称的枚举则返回列出可接受值的 *UnknownValueError
+func (c *Enums[P, B, M]) ParseByName(name string) (*Enum[P, B, M], error) {
+	if res, ok := c.LookupByName(name); ok {
+		return res, nil
+	}
+	return nil, c.newUnknownValueError(LookupName, name)
+}
+
+// ParseByBasic finds an Enum using its Go native enum value
+// Returns *UnknownValueError listing the accepted values if no enum with the given basic enum exists
+//
+// 通过 Go 原生枚举值解析 Enum
+// 如果不存在具有给定 basic 枚举的枚举则返回列出可接受值的 *UnknownValueError
+func (c *Enums[P, B, M]) ParseByBasic(basic B) (*Enum[P, B, M], error) {
+	if res, ok := c.LookupByBasic(basic); ok {
+		return res, nil
+	}
+	return nil, c.newUnknownValueError(LookupBasic, fmt.Sprint(basic))
+}
+
+// newUnknownValueError creates the *UnknownValueError of the given lookup kind and input
+// Collects each accepted code, name and basic value in the defined sequence
+//
+// 创建给定查找类型和输入的 *UnknownValueError
+// 按定义次序收集各可接受的代码、名称和 basic 值
+func (c *Enums[P, B, M]) newUnknownValueError(kind LookupKind, input string) *UnknownValueError {
+	var res = &UnknownValueError{
+		FullName: c.FullName(),
+		Kind:     kind,
+		Input:    input,
+		Codes:    make([]int32, 0, len(c.enumElements)),
+		Names:    make([]string, 0, len(c.enumElements)),
+		Basics:   make([]string, 0, len(c.enumElements)),
+	}
+	for _, item := range c.enumElements {
+		res.Codes = append(res.Codes, item.Code())
+		res.Names = append(res.Names, item.Name())
+		res.Basics = append(res.Basics, fmt.Sprint(item.Basic()))
+	}
+	res.Suggestions = c.Suggest(kind, input)
+	return res
+}
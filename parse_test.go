@@ -0,0 +1,107 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// newStatusEnums builds the StatusEnum collection shared by the parse tests
+//
+// newStatusEnums 构建解析测试共用的 StatusEnum 集合
+func newStatusEnums() *protoenum.Enums[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc] {
+	return protoenum.NewEnums(
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_UNKNOWN, "unknown", "未知"),
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_SUCCESS, "success", "成功"),
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_FAILURE, "failure", "失败"),
+	)
+}
+
+// TestEnums_ParseByName tests parsing with valid and invalid names
+// Checks the error carries the enum full name, input and accepted names
+//
+// 验证使用有效和无效名称解析
+// 测试错误携带枚举全名、输入以及可接受的名称
+func TestEnums_ParseByName(t *testing.T) {
+	enums := newStatusEnums()
+
+	enum, err := enums.ParseByName("SUCCESS")
+	require.NoError(t, err)
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, enum.Proto())
+
+	enum, err = enums.ParseByName("DONE")
+	require.Nil(t, enum)
+	require.Error(t, err)
+	t.Log(err)
+
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, "protoenumstatus.StatusEnum", unknownValueError.FullName)
+	require.Equal(t, protoenum.LookupName, unknownValueError.Kind)
+	require.Equal(t, "DONE", unknownValueError.Input)
+	require.Equal(t, []string{"UNKNOWN", "SUCCESS", "FAILURE"}, unknownValueError.Names)
+	require.Equal(t, []string{"unknown", "success", "failure"}, unknownValueError.Basics)
+	require.Equal(t, `protoenum: invalid name "DONE" of protoenumstatus.StatusEnum, expected one of [UNKNOWN SUCCESS FAILURE]`, err.Error())
+}
+
+// TestEnums_ParseByCode tests parsing with valid and invalid codes
+//
+// 验证使用有效和无效代码解析
+func TestEnums_ParseByCode(t *testing.T) {
+	enums := newStatusEnums()
+
+	enum, err := enums.ParseByCode(2)
+	require.NoError(t, err)
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, enum.Proto())
+
+	_, err = enums.ParseByCode(9)
+	require.Error(t, err)
+	t.Log(err)
+
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, protoenum.LookupCode, unknownValueError.Kind)
+	require.Equal(t, "9", unknownValueError.Input)
+	require.Equal(t, []int32{0, 1, 2}, unknownValueError.Codes)
+}
+
+// TestEnums_ParseByBasic tests parsing with valid and invalid basic values
+// Checks the error message lists the accepted basic values
+//
+// 验证使用有效和无效 basic 值解析
+// 测试错误信息列出可接受的 basic 值
+func TestEnums_ParseByBasic(t *testing.T) {
+	enums := newStatusEnums()
+
+	enum, err := enums.ParseByBasic("failure")
+	require.NoError(t, err)
+	require.Equal(t, "失败", enum.Meta().Desc())
+
+	_, err = enums.ParseByBasic("done")
+	require.Error(t, err)
+	t.Log(err)
+
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, protoenum.LookupBasic, unknownValueError.Kind)
+	require.Equal(t, "done", unknownValueError.Input)
+	require.Equal(t, `protoenum: invalid basic "done" of protoenumstatus.StatusEnum, expected one of [unknown success failure]`, err.Error())
+}
+
+// TestEnums_ParseByName_NoDescriptor tests the Go type name is used without descriptor
+//
+// 验证没有描述符时使用 Go 类型名称
+func TestEnums_ParseByName_NoDescriptor(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(plainEnum(0), "zero"),
+		protoenum.NewEnum(plainEnum(1), "one"),
+	)
+
+	_, err := enums.ParseByName("2")
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, "protoenum_test.plainEnum", unknownValueError.FullName)
+}
@@ -10,6 +10,8 @@
 package protoenum
 
 import (
+	"strconv"
+
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -51,9 +53,11 @@ type ProtoEnum interface {
 // 通过 Meta() 方法关联枚举值与自定义元数据
 // 使用三泛型在 protobuf、Go 原生枚举和元数据类型间保持类型安全
 type Enum[protoEnum ProtoEnum, basicEnum comparable, metaType any] struct {
-	proto protoEnum // Source Protocol Buffer enum value // 源 Protocol Buffer 枚举值
-	basic basicEnum // Go native enum value (e.g. type StatusType string) // Go 原生枚举值（如 type StatusType string）
-	meta  metaType  // Custom metadata of the enum // 枚举的自定义元数据
+	proto      protoEnum // Source Protocol Buffer enum value // 源 Protocol Buffer 枚举值
+	basic      basicEnum // Go native enum value (e.g. type StatusType string) // Go 原生枚举值（如 type StatusType string）
+	meta       metaType  // Custom metadata of the enum // 枚举的自定义元数据
+	unknown    bool      // True when the number is not registered, see IsUnknown // 数字未注册时为 true，参见 IsUnknown
+	deprecated bool      // True when the value is marked [deprecated = true], see Deprecated // 值标记为 [deprecated = true] 时为 true，参见 Deprecated
 }
 
 // NewEnum creates a new Enum instance binding protobuf enum with Go native enum
@@ -67,9 +71,10 @@ type Enum[protoEnum ProtoEnum, basicEnum comparable, metaType any] struct {
 // 返回创建的 Enum 实例指针以便链式调用
 func NewEnum[protoEnum ProtoEnum, basicEnum comparable](proto protoEnum, basic basicEnum) *Enum[protoEnum, basicEnum, *MetaNone] {
 	return &Enum[protoEnum, basicEnum, *MetaNone]{
-		proto: proto,
-		basic: basic,
-		meta:  &MetaNone{},
+		proto:      proto,
+		basic:      basic,
+		meta:       &MetaNone{},
+		deprecated: protoDeprecated(proto),
 	}
 }
 
@@ -84,9 +89,10 @@ func NewEnum[protoEnum ProtoEnum, basicEnum comparable](proto protoEnum, basic b
 // description 参数提供用于文档和显示的自定义描述
 func NewEnumWithDesc[protoEnum ProtoEnum, basicEnum comparable](proto protoEnum, basic basicEnum, description string) *Enum[protoEnum, basicEnum, *MetaDesc] {
 	return &Enum[protoEnum, basicEnum, *MetaDesc]{
-		proto: proto,
-		basic: basic,
-		meta:  &MetaDesc{description: description},
+		proto:      proto,
+		basic:      basic,
+		meta:       &MetaDesc{description: description},
+		deprecated: protoDeprecated(proto),
 	}
 }
 
@@ -101,9 +107,10 @@ func NewEnumWithDesc[protoEnum ProtoEnum, basicEnum comparable](proto protoEnum,
 // meta 参数接受任意自定义元数据类型（如双语描述）
 func NewEnumWithMeta[protoEnum ProtoEnum, basicEnum comparable, metaType any](proto protoEnum, basic basicEnum, meta metaType) *Enum[protoEnum, basicEnum, metaType] {
 	return &Enum[protoEnum, basicEnum, metaType]{
-		proto: proto,
-		basic: basic,
-		meta:  meta,
+		proto:      proto,
+		basic:      basic,
+		meta:       meta,
+		deprecated: protoDeprecated(proto),
 	}
 }
 
@@ -140,10 +147,17 @@ func (c *Enum[protoEnum, basicEnum, metaType]) Code() int32 {
 
 // Name returns the string name of the enum value
 // Gets the Protocol Buffer enum's string representation
+// Unknown enums keep the name declared in the descriptor, e.g. a value missing from the collection
+// Unknown enums without a declared name return the number as the name, e.g. "7"
 //
 // 返回枚举值的字符串名称
 // 获取 Protocol Buffer 枚举的字符串表示
+// 未知枚举保留描述符中声明的名称，例如集合中缺少的值
+// 没有声明名称的未知枚举返回数字作为名称，例如 "7"
 func (c *Enum[protoEnum, basicEnum, metaType]) Name() string {
+	if c.unknown && valueDescriptor(c.proto) == nil {
+		return strconv.Itoa(int(c.proto.Number()))
+	}
 	return c.proto.String()
 }
 
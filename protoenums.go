@@ -8,68 +8,150 @@
 package protoenum
 
 import (
-	"slices"
+	"fmt"
+	"sync/atomic"
 
 	"github.com/go-xlan/protoenum/internal/utils"
 	"github.com/yyle88/must"
 	"github.com/yyle88/tern/slicetern"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Enums manages a collection of Enum instances with indexed lookups
 // Maintains multiple maps enabling efficient lookup using different identifiers
 // Provides O(1) lookup when searching proto, code, name, and basic value
+// Serves code and proto lookups from a slice when the codes are dense, e.g. 0..N
 // Includes a configurable default value returned when lookups miss
+// Lookups are safe to call from many goroutines once configuration is done
+// Call Freeze when configuration is done, mutators panic with ErrFrozen afterwards
 //
 // Enums 管理 Enum 实例集合并提供索引查找
 // 维护四个映射表以通过不同标识符高效检索
 // 为 proto、代码、名称和 basic 枚举值搜索提供 O(1) 查找性能
+// 代码紧凑时（如 0..N）使用切片提供代码和 proto 查找
 // 支持在查找失败时返回可选的默认值
+// 配置完成后，查找方法可被多个 goroutine 并发调用
+// 配置完成后调用 Freeze，之后的修改操作会以 ErrFrozen panic
 type Enums[P ProtoEnum, B comparable, M any] struct {
-	enumElements []*Enum[P, B, M]          // Holds complete Enum instances in defined sequence // 存放所有 Enum 实例，并维持其定义的次序
-	mapProtoEnum map[P]*Enum[P, B, M]      // Map from proto enum to Enum // 从 proto 枚举到 Enum 的映射
-	mapCode2Enum map[int32]*Enum[P, B, M]  // Map from numeric code to Enum // 从数字代码到 Enum 的映射
-	mapName2Enum map[string]*Enum[P, B, M] // Map from name string to Enum // 从名称字符串到 Enum 的映射
-	mapBasicEnum map[B]*Enum[P, B, M]      // Map from basic enum to Enum // 从 basic 枚举到 Enum 的映射
-	defaultValue *Enum[P, B, M]            // Configurable default value when lookup misses // 查找失败时的可选默认值
-	defaultValid *bool                     // When true, default is treated as valid in ListValidXxx // 为 true 时，ListValidXxx 将默认值视为有效
+	enumElements   []*Enum[P, B, M]            // Holds complete Enum instances in defined sequence // 存放所有 Enum 实例，并维持其定义的次序
+	mapProtoEnum   map[P]*Enum[P, B, M]        // Map from proto enum to Enum // 从 proto 枚举到 Enum 的映射
+	mapCode2Enum   map[int32]*Enum[P, B, M]    // Map from numeric code to Enum // 从数字代码到 Enum 的映射
+	mapName2Enum   map[string]*Enum[P, B, M]   // Map from name string to Enum // 从名称字符串到 Enum 的映射
+	mapBasicEnum   map[B]*Enum[P, B, M]        // Map from basic enum to Enum // 从 basic 枚举到 Enum 的映射
+	defaultValue   *Enum[P, B, M]              // Configurable default value when lookup misses // 查找失败时的可选默认值
+	defaultValid   *bool                       // When true, default is treated as valid in ListValidXxx // 为 true 时，ListValidXxx 将默认值视为有效
+	frozenStage    atomic.Bool                 // Set by Freeze, rejects later mutation // 由 Freeze 设置，拒绝之后的修改
+	denseCodes     *denseIndex[P, B, M]        // Slice index when codes are dense, nil when sparse // 代码紧凑时的切片索引，稀疏时为 nil
+	nameMatches    NameMatch                   // Relaxed name-matching modes // 宽松名称匹配模式
+	namePrefix     string                      // ENUM_NAME_ prefix derived from the descriptor // 由描述符推导的 ENUM_NAME_ 前缀
+	mapNameFold    map[string]*Enum[P, B, M]   // Map from relaxed name key to Enum, nil when no mode // 从宽松名称键到 Enum 的映射，无模式时为 nil
+	aliasNames     []string                    // Alias names in registration sequence // 按注册次序排列的别名名称
+	fallbackHook   func(event FallbackEvent)   // Fired when GetByXxx falls back to the default // GetByXxx 回退到默认值时触发
+	missCounters   missCounters                // Fallback counts of each lookup kind // 各查找类型的回退次数
+	descriptor     protoreflect.EnumDescriptor // Enum descriptor, nil when P does not expose one // 枚举描述符，P 无法提供时为 nil
+	deprecatedHook func(event DeprecatedEvent) // Fired when a lookup resolves to a deprecated value // 查找解析到弃用值时触发
 }
 
 // NewEnums creates a new Enums collection from the given Enum instances
 // Builds indexed maps enabling efficient lookup using proto, code, name, and basic value
 // The first item becomes the default value if provided
-// Returns a reference to the created Enums collection, usable in lookup operations
+// Panics with *ConflictError on nil items or duplicate proto, code, name, and basic value
 //
 // 从给定的 Enum 实例创建新的 Enums 集合
 // 构建索引映射以通过 proto、代码、名称和 basic 枚举值高效查找
 // 如果提供了参数，第一个项成为默认值
-// 返回创建的 Enums 集合指针，可用于各种查找操作
+// 当存在 nil 项或 proto、代码、名称、basic 枚举值重复时以 *ConflictError panic
 func NewEnums[P ProtoEnum, B comparable, M any](params ...*Enum[P, B, M]) *Enums[P, B, M] {
+	res, err := TryNewEnums(params...)
+	if err != nil {
+		panic(err) // Panic with the *ConflictError itself so callers can recover it // 直接以 *ConflictError panic，便于调用方 recover
+	}
+	return must.Full(res)
+}
+
+// TryNewEnums creates a new Enums collection from the given Enum instances without panics
+// Checks each item in one pass and reports every collision through *ConflictError
+// Use this when validating config-driven collections that must be reported instead of crashing
+// The first item becomes the default value if provided, the same as NewEnums
+// With proto option allow_alias, a repeated proto adds its basic value as an alias instead of conflicting,
+// and the alias names declared in the descriptor resolve through LookupByName
+//
+// 从给定的 Enum 实例创建新的 Enums 集合，不会 panic
+// 一次性检查所有项，并通过 *ConflictError 报告所有冲突
+// 用于校验配置驱动的集合，使其能报告错误而不是崩溃
+// 与 NewEnums 相同，如果提供了参数，第一个项成为默认值
+// 设置 proto 选项 allow_alias 时，重复的 proto 会将其 basic 值添加为别名而不是冲突，
+// 描述符中声明的别名名称也可以通过 LookupByName 解析
+func TryNewEnums[P ProtoEnum, B comparable, M any](params ...*Enum[P, B, M]) (*Enums[P, B, M], error) {
 	res := &Enums[P, B, M]{
-		enumElements: slices.Clone(params), // Clone the slice to preserve the defined sequence of enum elements // 克隆切片以保持枚举元素的定义次序
+		enumElements: make([]*Enum[P, B, M], 0, len(params)), // Filled in the defined sequence of enum elements // 按枚举元素的定义次序填充
 		mapProtoEnum: make(map[P]*Enum[P, B, M], len(params)),
 		mapCode2Enum: make(map[int32]*Enum[P, B, M], len(params)),
 		mapName2Enum: make(map[string]*Enum[P, B, M], len(params)),
 		mapBasicEnum: make(map[B]*Enum[P, B, M], len(params)),
 		defaultValue: slicetern.V0(params), // Set first item as default if available // 如果有参数，将第一个设置为默认值
 		defaultValid: nil,
+		descriptor:   paramsDescriptor(params),
+	}
+
+	var allowAlias = descriptorAllowAlias(res.descriptor)
+	var conflicts []Conflict
+	var positions = make(map[*Enum[P, B, M]]int, len(params))
+	addConflict := func(kind ConflictKind, idx int, prior *Enum[P, B, M], value any) {
+		conflicts = append(conflicts, Conflict{Kind: kind, Index: idx, Prior: positions[prior], Value: fmt.Sprint(value)})
 	}
-	for _, enum := range params {
-		must.Full(enum)
+	for idx, enum := range params {
+		if enum == nil {
+			conflicts = append(conflicts, Conflict{Kind: ConflictNil, Index: idx, Prior: -1})
+			continue
+		}
+		if _, ok := positions[enum]; !ok {
+			positions[enum] = idx
+		}
+
+		// With allow_alias a repeated proto registers its basic value as an alias of the prior item
+		// 设置 allow_alias 时，重复的 proto 将其 basic 值注册为先前项的别名
+		if prior, ok := res.mapProtoEnum[enum.Proto()]; ok && allowAlias {
+			if holder, ok := res.mapBasicEnum[enum.Basic()]; ok && holder != prior {
+				addConflict(ConflictBasic, idx, holder, enum.Basic())
+			} else {
+				res.mapBasicEnum[enum.Basic()] = prior
+			}
+			continue
+		}
+		res.enumElements = append(res.enumElements, enum)
 
 		// Check proto collision // 检查 proto 枚举冲突
-		must.Null(res.mapProtoEnum[enum.Proto()])
-		res.mapProtoEnum[enum.Proto()] = enum
+		if prior, ok := res.mapProtoEnum[enum.Proto()]; ok {
+			addConflict(ConflictProto, idx, prior, enum.Proto())
+		} else {
+			res.mapProtoEnum[enum.Proto()] = enum
+		}
 		// Check code collision // 检查代码冲突
-		must.Null(res.mapCode2Enum[enum.Code()])
-		res.mapCode2Enum[enum.Code()] = enum
+		if prior, ok := res.mapCode2Enum[enum.Code()]; ok {
+			addConflict(ConflictCode, idx, prior, enum.Code())
+		} else {
+			res.mapCode2Enum[enum.Code()] = enum
+		}
 		// Check name collision // 检查名称冲突
-		must.Null(res.mapName2Enum[enum.Name()])
-		res.mapName2Enum[enum.Name()] = enum
+		if prior, ok := res.mapName2Enum[enum.Name()]; ok {
+			addConflict(ConflictName, idx, prior, enum.Name())
+		} else {
+			res.mapName2Enum[enum.Name()] = enum
+		}
 		// Check basic collision // 检查 basic 枚举冲突
-		must.Null(res.mapBasicEnum[enum.Basic()])
-		res.mapBasicEnum[enum.Basic()] = enum
+		if prior, ok := res.mapBasicEnum[enum.Basic()]; ok {
+			addConflict(ConflictBasic, idx, prior, enum.Basic())
+		} else {
+			res.mapBasicEnum[enum.Basic()] = enum
+		}
 	}
-	return res
+	if len(conflicts) > 0 {
+		return nil, &ConflictError{Conflicts: conflicts}
+	}
+	res.addDescriptorAliases()
+	res.denseCodes = newDenseIndex(res.mapCode2Enum)
+	return res, nil
 }
 
 // LookupByProto finds an Enum using its Protocol Buffer enum value
@@ -80,7 +162,7 @@ func NewEnums[P ProtoEnum, B comparable, M any](params ...*Enum[P, B, M]) *Enums
 // 找到时返回 Enum 和 true，否则返回 nil 和 false
 // 当需要在访问值之前检查是否存在时使用此方法
 func (c *Enums[P, B, M]) LookupByProto(proto P) (*Enum[P, B, M], bool) {
-	if res, ok := c.mapProtoEnum[proto]; ok {
+	if res, ok := c.findByProto(proto); ok {
 		return must.Full(res), true
 	}
 	return nil, false
@@ -89,17 +171,19 @@ func (c *Enums[P, B, M]) LookupByProto(proto P) (*Enum[P, B, M], bool) {
 // GetByProto finds an Enum using its Protocol Buffer enum value
 // Uses the enum's numeric code when searching in the collection
 // Returns default value if the enum is not found in the collection
+// Counts the miss and fires the fallback hook set by WithFallbackHook
 // Panics if no default value has been configured
 //
 // 通过 Protocol Buffer 枚举值检索 Enum
 // 使用枚举的数字代码在集合中查找
 // 如果在集合中找不到枚举则返回默认值
+// 统计未命中次数并触发 WithFallbackHook 设置的回退回调
 // 如果未配置默认值则会 panic
 func (c *Enums[P, B, M]) GetByProto(proto P) *Enum[P, B, M] {
-	if res, ok := c.mapProtoEnum[proto]; ok {
+	if res, ok := c.findByProto(proto); ok {
 		return must.Full(res)
 	}
-	return c.GetDefault()
+	return c.fallback(LookupProto, proto)
 }
 
 // MustGetByProto finds an Enum using its Protocol Buffer enum value
@@ -108,7 +192,8 @@ func (c *Enums[P, B, M]) GetByProto(proto P) *Enum[P, B, M] {
 // 通过 Protocol Buffer 枚举值检索 Enum
 // 如果在集合中找不到枚举则会 panic
 func (c *Enums[P, B, M]) MustGetByProto(proto P) *Enum[P, B, M] {
-	return must.Nice(c.mapProtoEnum[proto])
+	res, _ := c.findByProto(proto)
+	return must.Nice(res)
 }
 
 // LookupByCode finds an Enum using its numeric code
@@ -119,26 +204,28 @@ func (c *Enums[P, B, M]) MustGetByProto(proto P) *Enum[P, B, M] {
 // 找到时返回 Enum 和 true，否则返回 nil 和 false
 // 当需要在访问值之前检查是否存在时使用此方法
 func (c *Enums[P, B, M]) LookupByCode(code int32) (*Enum[P, B, M], bool) {
-	if res, ok := c.mapCode2Enum[code]; ok {
+	if res, ok := c.findByCode(code); ok {
 		return must.Full(res), true
 	}
 	return nil, false
 }
 
 // GetByCode finds an Enum using its numeric code
-// Performs direct map lookup using the int32 code value
+// Performs direct slice or map lookup using the int32 code value
 // Returns default value if no enum with the given code exists
+// Counts the miss and fires the fallback hook set by WithFallbackHook
 // Panics if no default value has been configured
 //
 // 通过数字代码检索 Enum
-// 使用 int32 代码值执行直接映射查找
+// 使用 int32 代码值执行直接切片或映射查找
 // 如果不存在具有给定代码的枚举则返回默认值
+// 统计未命中次数并触发 WithFallbackHook 设置的回退回调
 // 如果未配置默认值则会 panic
 func (c *Enums[P, B, M]) GetByCode(code int32) *Enum[P, B, M] {
-	if res, ok := c.mapCode2Enum[code]; ok {
+	if res, ok := c.findByCode(code); ok {
 		return must.Full(res)
 	}
-	return c.GetDefault()
+	return c.fallback(LookupCode, code)
 }
 
 // MustGetByCode finds an Enum using its numeric code
@@ -147,18 +234,21 @@ func (c *Enums[P, B, M]) GetByCode(code int32) *Enum[P, B, M] {
 // 通过数字代码检索 Enum
 // 如果不存在具有给定代码的枚举则会 panic
 func (c *Enums[P, B, M]) MustGetByCode(code int32) *Enum[P, B, M] {
-	return must.Nice(c.mapCode2Enum[code])
+	res, _ := c.findByCode(code)
+	return must.Nice(res)
 }
 
 // LookupByName finds an Enum using its string name
 // Returns the Enum and true if found, nil and false otherwise
+// Also matches relaxed names once WithNameMatch is set
 // Use this when you need to check existence before accessing the value
 //
 // 通过字符串名称查找 Enum
 // 找到时返回 Enum 和 true，否则返回 nil 和 false
+// 设置 WithNameMatch 后也会匹配宽松名称
 // 当需要在访问值之前检查是否存在时使用此方法
 func (c *Enums[P, B, M]) LookupByName(name string) (*Enum[P, B, M], bool) {
-	if res, ok := c.mapName2Enum[name]; ok {
+	if res, ok := c.findByName(name); ok {
 		return must.Full(res), true
 	}
 	return nil, false
@@ -167,17 +257,19 @@ func (c *Enums[P, B, M]) LookupByName(name string) (*Enum[P, B, M], bool) {
 // GetByName finds an Enum using its string name
 // Performs direct map lookup using the enum name string
 // Returns default value if no enum with the given name exists
+// Counts the miss and fires the fallback hook set by WithFallbackHook
 // Panics if no default value has been configured
 //
 // 通过字符串名称检索 Enum
 // 使用枚举名称字符串执行直接映射查找
 // 如果不存在具有给定名称的枚举则返回默认值
+// 统计未命中次数并触发 WithFallbackHook 设置的回退回调
 // 如果未配置默认值则会 panic
 func (c *Enums[P, B, M]) GetByName(name string) *Enum[P, B, M] {
-	if res, ok := c.mapName2Enum[name]; ok {
+	if res, ok := c.findByName(name); ok {
 		return must.Full(res)
 	}
-	return c.GetDefault()
+	return c.fallback(LookupName, name)
 }
 
 // MustGetByName finds an Enum using its string name
@@ -186,7 +278,22 @@ func (c *Enums[P, B, M]) GetByName(name string) *Enum[P, B, M] {
 // 通过字符串名称检索 Enum
 // 如果不存在具有给定名称的枚举则会 panic
 func (c *Enums[P, B, M]) MustGetByName(name string) *Enum[P, B, M] {
-	return must.Nice(c.mapName2Enum[name])
+	res, _ := c.findByName(name)
+	return must.Nice(res)
+}
+
+// findByBasic finds an Enum using its Go native enum value through the map
+// Fires the deprecated hook when the found Enum is deprecated
+//
+// 通过映射表查找 Go 原生枚举值对应的 Enum
+// 找到的 Enum 已弃用时触发弃用回调
+func (c *Enums[P, B, M]) findByBasic(basic B) (*Enum[P, B, M], bool) {
+	res, ok := c.mapBasicEnum[basic]
+	if !ok {
+		return nil, false
+	}
+	c.noticeDeprecated(LookupBasic, res)
+	return res, true
 }
 
 // LookupByBasic finds an Enum using its Go native enum value
@@ -197,7 +304,7 @@ func (c *Enums[P, B, M]) MustGetByName(name string) *Enum[P, B, M] {
 // 找到时返回 Enum 和 true，否则返回 nil 和 false
 // 当需要在访问值之前检查是否存在时使用此方法
 func (c *Enums[P, B, M]) LookupByBasic(basic B) (*Enum[P, B, M], bool) {
-	if res, ok := c.mapBasicEnum[basic]; ok {
+	if res, ok := c.findByBasic(basic); ok {
 		return must.Full(res), true
 	}
 	return nil, false
@@ -206,17 +313,19 @@ func (c *Enums[P, B, M]) LookupByBasic(basic B) (*Enum[P, B, M], bool) {
 // GetByBasic finds an Enum using its Go native enum value
 // Performs direct map lookup using the basic enum value
 // Returns default value if no enum with the given basic enum exists
+// Counts the miss and fires the fallback hook set by WithFallbackHook
 // Panics if no default value has been configured
 //
 // 通过 Go 原生枚举值检索 Enum
 // 使用 basic 枚举值执行直接映射查找
 // 如果不存在具有给定 basic 枚举的枚举则返回默认值
+// 统计未命中次数并触发 WithFallbackHook 设置的回退回调
 // 如果未配置默认值则会 panic
 func (c *Enums[P, B, M]) GetByBasic(basic B) *Enum[P, B, M] {
-	if res, ok := c.mapBasicEnum[basic]; ok {
+	if res, ok := c.findByBasic(basic); ok {
 		return must.Full(res)
 	}
-	return c.GetDefault()
+	return c.fallback(LookupBasic, basic)
 }
 
 // MustGetByBasic finds an Enum using its Go native enum value
@@ -225,7 +334,8 @@ func (c *Enums[P, B, M]) GetByBasic(basic B) *Enum[P, B, M] {
 // 通过 Go 原生枚举值检索 Enum
 // 如果不存在具有给定 basic 枚举的枚举则会 panic
 func (c *Enums[P, B, M]) MustGetByBasic(basic B) *Enum[P, B, M] {
-	return must.Nice(c.mapBasicEnum[basic])
+	res, _ := c.findByBasic(basic)
+	return must.Nice(res)
 }
 
 // GetDefault returns the current default Enum value
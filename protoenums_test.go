@@ -1,6 +1,7 @@
 package protoenum_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/go-xlan/protoenum"
@@ -278,3 +279,88 @@ func TestEnums_ListValidBasics(t *testing.T) {
 	require.Equal(t, ResultTypeMiss, allBasics[2])
 	require.Equal(t, ResultTypeSkip, allBasics[3])
 }
+
+// TestTryNewEnums tests building a collection without conflicts returns no error
+//
+// 验证无冲突时构建集合不返回错误
+func TestTryNewEnums(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+		StatusTypeSuccess StatusType = "success"
+		StatusTypeFailure StatusType = "failure"
+	)
+
+	enums, err := protoenum.TryNewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_FAILURE, StatusTypeFailure),
+	)
+	require.NoError(t, err)
+	require.Equal(t, StatusTypeUnknown, enums.GetDefaultBasic())
+	require.Equal(t, StatusTypeSuccess, enums.GetByCode(1).Basic())
+}
+
+// TestTryNewEnums_Conflicts tests each conflict is reported in one pass
+// Checks nil items, duplicate proto, code, name, and basic value are all listed
+//
+// 验证一次性报告所有冲突
+// 测试 nil 项以及重复的 proto、代码、名称和 basic 值都会被列出
+func TestTryNewEnums_Conflicts(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+		StatusTypeSuccess StatusType = "success"
+	)
+
+	enums, err := protoenum.TryNewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeSuccess),
+		nil,
+		protoenum.NewEnum(protoenumstatus.StatusEnum_FAILURE, StatusTypeSuccess),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusType("done")),
+	)
+	require.Nil(t, enums)
+	require.Error(t, err)
+	t.Log(err)
+
+	var conflictError *protoenum.ConflictError
+	require.True(t, errors.As(err, &conflictError))
+	require.Equal(t, []protoenum.Conflict{
+		{Kind: protoenum.ConflictNil, Index: 2, Prior: -1},
+		{Kind: protoenum.ConflictBasic, Index: 3, Prior: 1, Value: "success"},
+		{Kind: protoenum.ConflictProto, Index: 4, Prior: 1, Value: "SUCCESS"},
+		{Kind: protoenum.ConflictCode, Index: 4, Prior: 1, Value: "1"},
+		{Kind: protoenum.ConflictName, Index: 4, Prior: 1, Value: "SUCCESS"},
+	}, conflictError.Conflicts)
+}
+
+// TestNewEnums_Conflicts tests NewEnums panics with the conflict error
+// Checks the recovered value is *ConflictError listing the duplicate basic value
+//
+// 验证 NewEnums 在冲突时以冲突错误 panic
+// 测试 recover 得到的值是列出重复 basic 值的 *ConflictError
+func TestNewEnums_Conflicts(t *testing.T) {
+	type StatusType string
+	const (
+		StatusTypeUnknown StatusType = "unknown"
+	)
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		err, ok := r.(error)
+		require.True(t, ok)
+		t.Log(err)
+
+		var conflictError *protoenum.ConflictError
+		require.True(t, errors.As(err, &conflictError))
+		require.Equal(t, []protoenum.Conflict{
+			{Kind: protoenum.ConflictBasic, Index: 1, Prior: 0, Value: "unknown"},
+		}, conflictError.Conflicts)
+	}()
+	protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, StatusTypeUnknown),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, StatusTypeUnknown),
+	)
+}
@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: protoenumplan/protoenumplan.proto
+
+package protoenumplan
+
+import (
+	_ "github.com/go-xlan/protoenum/protos/protoenum"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PlanEnum keeps a retired plan marked deprecated until callers move away
+// PlanEnum 保留一个标记为弃用的已下线套餐，直到调用方完成迁移
+type PlanEnum int32
+
+const (
+	PlanEnum_UNKNOWN PlanEnum = 0
+	PlanEnum_BASIC   PlanEnum = 1
+	PlanEnum_PREMIUM PlanEnum = 2
+	// Deprecated: Marked as deprecated in protoenumplan/protoenumplan.proto.
+	PlanEnum_LEGACY PlanEnum = 3
+)
+
+// Enum value maps for PlanEnum.
+var (
+	PlanEnum_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "BASIC",
+		2: "PREMIUM",
+		3: "LEGACY",
+	}
+	PlanEnum_value = map[string]int32{
+		"UNKNOWN": 0,
+		"BASIC":   1,
+		"PREMIUM": 2,
+		"LEGACY":  3,
+	}
+)
+
+func (x PlanEnum) Enum() *PlanEnum {
+	p := new(PlanEnum)
+	*p = x
+	return p
+}
+
+func (x PlanEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_protoenumplan_protoenumplan_proto_enumTypes[0].Descriptor()
+}
+
+func (PlanEnum) Type() protoreflect.EnumType {
+	return &file_protoenumplan_protoenumplan_proto_enumTypes[0]
+}
+
+func (x PlanEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanEnum.Descriptor instead.
+func (PlanEnum) EnumDescriptor() ([]byte, []int) {
+	return file_protoenumplan_protoenumplan_proto_rawDescGZIP(), []int{0}
+}
+
+var File_protoenumplan_protoenumplan_proto protoreflect.FileDescriptor
+
+const file_protoenumplan_protoenumplan_proto_rawDesc = "" +
+	"\n" +
+	"!protoenumplan/protoenumplan.proto\x12\rprotoenumplan\x1a\x17protoenum/options.proto*\xbe\x01\n" +
+	"\bPlanEnum\x12(\n" +
+	"\aUNKNOWN\x10\x00\x1a\x1b\xca\xf3\x18\aunknown\xd2\xf3\x18\fPlan unknown\x12\"\n" +
+	"\x05BASIC\x10\x01\x1a\x17\xca\xf3\x18\x05basic\xd2\xf3\x18\n" +
+	"Basic plan\x12(\n" +
+	"\aPREMIUM\x10\x02\x1a\x1b\xca\xf3\x18\apremium\xd2\xf3\x18\fPremium plan\x12:\n" +
+	"\x06LEGACY\x10\x03\x1a.\xca\xf3\x18\x06legacy\xd2\xf3\x18\x1eLegacy plan, replaced by BASIC\b\x01BR\n" +
+	"\rprotoenumplanP\x01Z?github.com/go-xlan/protoenum/protos/protoenumplan;protoenumplanb\x06proto3"
+
+var (
+	file_protoenumplan_protoenumplan_proto_rawDescOnce sync.Once
+	file_protoenumplan_protoenumplan_proto_rawDescData []byte
+)
+
+func file_protoenumplan_protoenumplan_proto_rawDescGZIP() []byte {
+	file_protoenumplan_protoenumplan_proto_rawDescOnce.Do(func() {
+		file_protoenumplan_protoenumplan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protoenumplan_protoenumplan_proto_rawDesc), len(file_protoenumplan_protoenumplan_proto_rawDesc)))
+	})
+	return file_protoenumplan_protoenumplan_proto_rawDescData
+}
+
+var file_protoenumplan_protoenumplan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protoenumplan_protoenumplan_proto_goTypes = []any{
+	(PlanEnum)(0), // 0: protoenumplan.PlanEnum
+}
+var file_protoenumplan_protoenumplan_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protoenumplan_protoenumplan_proto_init() }
+func file_protoenumplan_protoenumplan_proto_init() {
+	if File_protoenumplan_protoenumplan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protoenumplan_protoenumplan_proto_rawDesc), len(file_protoenumplan_protoenumplan_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protoenumplan_protoenumplan_proto_goTypes,
+		DependencyIndexes: file_protoenumplan_protoenumplan_proto_depIdxs,
+		EnumInfos:         file_protoenumplan_protoenumplan_proto_enumTypes,
+	}.Build()
+	File_protoenumplan_protoenumplan_proto = out.File
+	file_protoenumplan_protoenumplan_proto_goTypes = nil
+	file_protoenumplan_protoenumplan_proto_depIdxs = nil
+}
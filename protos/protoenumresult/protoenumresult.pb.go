@@ -7,6 +7,7 @@
 package protoenumresult
 
 import (
+	_ "github.com/go-xlan/protoenum/protos/protoenum"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -79,13 +80,14 @@ var File_protoenumresult_protoenumresult_proto protoreflect.FileDescriptor
 
 const file_protoenumresult_protoenumresult_proto_rawDesc = "" +
 	"\n" +
-	"%protoenumresult/protoenumresult.proto\x12\x0fprotoenumresult*7\n" +
+	"%protoenumresult/protoenumresult.proto\x12\x0fprotoenumresult\x1a\x17protoenum/options.proto*\xcf\x01\n" +
 	"\n" +
-	"ResultEnum\x12\v\n" +
-	"\aUNKNOWN\x10\x00\x12\b\n" +
-	"\x04PASS\x10\x01\x12\b\n" +
-	"\x04MISS\x10\x02\x12\b\n" +
-	"\x04SKIP\x10\x03BX\n" +
+	"ResultEnum\x12*\n" +
+	"\aUNKNOWN\x10\x00\x1a\x1d\xca\xf3\x18\aunknown\xd2\xf3\x18\x0eResult unknown\x121\n" +
+	"\x04PASS\x10\x01\x1a'\xca\xf3\x18\x04pass\xd2\xf3\x18\fCheck passed\xda\xf3\x18\vcolor=green\x12/\n" +
+	"\x04MISS\x10\x02\x1a%\xca\xf3\x18\x04miss\xd2\xf3\x18\fCheck missed\xda\xf3\x18\tcolor=red\x121\n" +
+	"\x04SKIP\x10\x03\x1a'\xca\xf3\x18\x04skip\xd2\xf3\x18\rCheck skipped\xda\xf3\x18\n" +
+	"color=grayBX\n" +
 	"\x0fprotoenumresultP\x01ZCgithub.com/go-xlan/protoenum/protos/protoenumresult;protoenumresultb\x06proto3"
 
 var (
@@ -7,6 +7,7 @@
 package protoenumstatus
 
 import (
+	_ "github.com/go-xlan/protoenum/protos/protoenum"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -74,12 +75,12 @@ var File_protoenumstatus_protoenumstatus_proto protoreflect.FileDescriptor
 
 const file_protoenumstatus_protoenumstatus_proto_rawDesc = "" +
 	"\n" +
-	"%protoenumstatus/protoenumstatus.proto\x12\x0fprotoenumstatus*3\n" +
+	"%protoenumstatus/protoenumstatus.proto\x12\x0fprotoenumstatus\x1a\x17protoenum/options.proto*\x97\x01\n" +
 	"\n" +
-	"StatusEnum\x12\v\n" +
-	"\aUNKNOWN\x10\x00\x12\v\n" +
-	"\aSUCCESS\x10\x01\x12\v\n" +
-	"\aFAILURE\x10\x02BX\n" +
+	"StatusEnum\x12*\n" +
+	"\aUNKNOWN\x10\x00\x1a\x1d\xca\xf3\x18\aunknown\xd2\xf3\x18\x0eStatus unknown\x12/\n" +
+	"\aSUCCESS\x10\x01\x1a\"\xca\xf3\x18\asuccess\xd2\xf3\x18\x13Operation succeeded\x12,\n" +
+	"\aFAILURE\x10\x02\x1a\x1f\xca\xf3\x18\afailure\xd2\xf3\x18\x10Operation failedBX\n" +
 	"\x0fprotoenumstatusP\x01ZCgithub.com/go-xlan/protoenum/protos/protoenumstatus;protoenumstatusb\x06proto3"
 
 var (
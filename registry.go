@@ -0,0 +1,98 @@
+package protoenum
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Register adds the collection to DefaultRegistry under its full name
+// Panics with ErrRegistered when another collection holds the full name
+//
+// 将集合以其全名注册到 DefaultRegistry
+// 当其他集合已持有该全名时以 ErrRegistered panic
+//
+// Example:
+//
+//	var enums = protoenum.NewEnumsFromOptions[protoenumstatus.StatusEnum]().Register()
+func (c *Enums[P, B, M]) Register() *Enums[P, B, M] {
+	DefaultRegistry.MustRegister(c)
+	return c
+}
+
+// Registry holds Enums collections keyed by the full name of their proto enum
+// Safe to use concurrently, e.g. a gateway resolving protoenumstatus.StatusEnum at runtime
+//
+// Registry 以 proto 枚举全名为键保存 Enums 集合
+// 可并发使用，例如网关在运行时解析 protoenumstatus.StatusEnum
+type Registry struct {
+	mutex         sync.RWMutex
+	mapName2Enums map[string]AnyEnums // Map from full name to collection // 从全名到集合的映射
+}
+
+// DefaultRegistry is the global Registry used by Enums.Register
+//
+// DefaultRegistry 是 Enums.Register 使用的全局 Registry
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry
+//
+// 创建空的 Registry
+func NewRegistry() *Registry {
+	return &Registry{mapName2Enums: map[string]AnyEnums{}}
+}
+
+// Register adds the collection under its full name
+// Registering the same collection again is accepted, another collection with the full name returns ErrRegistered
+//
+// 以全名注册集合
+// 重复注册同一集合是允许的，其他集合使用相同全名时返回 ErrRegistered
+func (r *Registry) Register(enums AnyEnums) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	fullName := enums.FullName()
+	if prior, ok := r.mapName2Enums[fullName]; ok && prior != enums {
+		return fmt.Errorf("%w: %s", ErrRegistered, fullName)
+	}
+	r.mapName2Enums[fullName] = enums
+	return nil
+}
+
+// MustRegister adds the collection under its full name, panics with ErrRegistered on collisions
+//
+// 以全名注册集合，发生冲突时以 ErrRegistered panic
+func (r *Registry) MustRegister(enums AnyEnums) {
+	if err := r.Register(enums); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup finds the collection registered under the full name, e.g. protoenumstatus.StatusEnum
+//
+// 查找以该全名注册的集合，例如 protoenumstatus.StatusEnum
+func (r *Registry) Lookup(fullName string) (AnyEnums, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	res, ok := r.mapName2Enums[fullName]
+	return res, ok
+}
+
+// List returns each registered collection sorted by full name
+//
+// 返回按全名排序的各已注册集合
+func (r *Registry) List() []AnyEnums {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var res = make([]AnyEnums, 0, len(r.mapName2Enums))
+	for _, enums := range r.mapName2Enums {
+		res = append(res, enums)
+	}
+	slices.SortFunc(res, func(a, b AnyEnums) int {
+		return strings.Compare(a.FullName(), b.FullName())
+	})
+	return res
+}
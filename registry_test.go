@@ -0,0 +1,76 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumclosed"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegistry_Lookup tests registering collections of different types and finding them using full names
+//
+// 验证注册不同类型的集合并通过全名查找
+func TestRegistry_Lookup(t *testing.T) {
+	registry := protoenum.NewRegistry()
+	require.NoError(t, registry.Register(newStatusEnums()))
+	require.NoError(t, registry.Register(protoenum.NewEnumsFromOptions[protoenumresult.ResultEnum]()))
+
+	enums, ok := registry.Lookup("protoenumstatus.StatusEnum")
+	require.True(t, ok)
+	item, ok := enums.LookupAnyByName("SUCCESS")
+	require.True(t, ok)
+	require.Equal(t, int32(1), item.Code)
+	require.Equal(t, "success", item.Basic)
+	require.Equal(t, "成功", item.Desc)
+
+	item, ok = enums.LookupAnyByCode(2)
+	require.True(t, ok)
+	require.Equal(t, "failure", item.Basic)
+
+	_, ok = enums.LookupAnyByCode(9)
+	require.False(t, ok)
+	_, ok = registry.Lookup("protoenumstatus.MissingEnum")
+	require.False(t, ok)
+
+	var fullNames []string
+	for _, item := range registry.List() {
+		fullNames = append(fullNames, item.FullName())
+		t.Log(item.FullName(), item.ListAny())
+	}
+	require.Equal(t, []string{"protoenumresult.ResultEnum", "protoenumstatus.StatusEnum"}, fullNames)
+}
+
+// TestRegistry_Register tests repeated registration of one collection and collisions of distinct ones
+//
+// 验证同一集合的重复注册以及不同集合之间的冲突
+func TestRegistry_Register(t *testing.T) {
+	registry := protoenum.NewRegistry()
+	enums := newStatusEnums()
+	require.NoError(t, registry.Register(enums))
+	require.NoError(t, registry.Register(enums))
+
+	err := registry.Register(newStatusEnums())
+	require.Error(t, err)
+	t.Log(err)
+	require.True(t, errors.Is(err, protoenum.ErrRegistered))
+
+	require.ErrorIs(t, recoverError(func() { registry.MustRegister(newStatusEnums()) }), protoenum.ErrRegistered)
+}
+
+// closedEnums registers itself into the default registry once as a package variable
+//
+// closedEnums 作为包级变量仅向默认注册表注册一次
+var closedEnums = protoenum.NewEnumsFromOptions[protoenumclosed.ClosedEnum]().Register()
+
+// TestEnums_Register tests self registration into the default registry
+//
+// 验证集合自行注册到默认注册表
+func TestEnums_Register(t *testing.T) {
+	res, ok := protoenum.DefaultRegistry.Lookup("protoenumclosed.ClosedEnum")
+	require.True(t, ok)
+	require.Equal(t, protoenum.AnyEnums(closedEnums), res)
+	require.NoError(t, protoenum.DefaultRegistry.Register(closedEnums))
+}
@@ -0,0 +1,217 @@
+package protoenum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// SQLDialect names the database flavor used when rendering DDL and seed data
+//
+// SQLDialect 表示渲染 DDL 和种子数据时使用的数据库方言
+type SQLDialect string
+
+const (
+	DialectPostgres SQLDialect = "postgres" // PostgreSQL, quotes identifiers with "" // PostgreSQL，使用 "" 引用标识符
+	DialectMySQL    SQLDialect = "mysql"    // MySQL, quotes identifiers with `` // MySQL，使用 `` 引用标识符
+	DialectSQLite   SQLDialect = "sqlite"   // SQLite, quotes identifiers with "" // SQLite，使用 "" 引用标识符
+)
+
+// SQLCreateTable renders the CREATE TABLE statement of the enum lookup table
+// Columns: code (primary key), name and basic (both unique), description (nullable)
+//
+// 渲染枚举查找表的 CREATE TABLE 语句
+// 列：code（主键）、name 和 basic（均唯一）、description（可为空）
+func (c *Enums[P, B, M]) SQLCreateTable(dialect SQLDialect, table string) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+	basicType, err := c.sqlBasicType(dialect)
+	if err != nil {
+		return "", err
+	}
+	var nameSize = 1
+	for _, enum := range c.enumElements {
+		nameSize = max(nameSize, utf8.RuneCountInString(enum.Name()))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE IF NOT EXISTS " + quoteIdent(dialect, table) + " (\n")
+	sb.WriteString("    " + quoteIdent(dialect, "code") + " INTEGER NOT NULL PRIMARY KEY,\n")
+	sb.WriteString("    " + quoteIdent(dialect, "name") + " VARCHAR(" + strconv.Itoa(nameSize) + ") NOT NULL UNIQUE,\n")
+	sb.WriteString("    " + quoteIdent(dialect, "basic") + " " + basicType + " NOT NULL UNIQUE,\n")
+	sb.WriteString("    " + quoteIdent(dialect, "description") + " TEXT\n")
+	sb.WriteString(");\n")
+	return sb.String(), nil
+}
+
+// SQLInsertRows renders the INSERT statement seeding the enum lookup table
+// The description comes from metadata exposing Desc(), e.g. MetaDesc, otherwise NULL
+// Returns ErrEmptyEnums when the collection has no values, since INSERT needs at least one row
+//
+// 渲染填充枚举查找表的 INSERT 语句
+// description 取自提供 Desc() 的元数据（如 MetaDesc），否则为 NULL
+// 集合没有值时返回 ErrEmptyEnums，因为 INSERT 至少需要一行
+func (c *Enums[P, B, M]) SQLInsertRows(dialect SQLDialect, table string) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+	if len(c.enumElements) == 0 {
+		return "", ErrEmptyEnums
+	}
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO " + quoteIdent(dialect, table) + " (")
+	sb.WriteString(strings.Join([]string{
+		quoteIdent(dialect, "code"),
+		quoteIdent(dialect, "name"),
+		quoteIdent(dialect, "basic"),
+		quoteIdent(dialect, "description"),
+	}, ", "))
+	sb.WriteString(") VALUES\n")
+	for idx, enum := range c.enumElements {
+		basic, err := basicLiteral(dialect, enum.Basic())
+		if err != nil {
+			return "", err
+		}
+		description := "NULL"
+		if meta, ok := any(enum.Meta()).(describer); ok {
+			description = quoteText(dialect, meta.Desc())
+		}
+		sb.WriteString("    (" + strconv.Itoa(int(enum.Code())) + ", " + quoteText(dialect, enum.Name()) + ", " + basic + ", " + description + ")")
+		if idx < len(c.enumElements)-1 {
+			sb.WriteString(",\n")
+		} else {
+			sb.WriteString(";\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// SQLCheck renders the CHECK constraint snippet limiting a referencing column to the enum values
+// The form chooses which values are listed, e.g. SQLBasic gives CHECK ("status" IN ('unknown', 'success'))
+// Returns ErrEmptyEnums when the collection has no values, since IN () is not valid SQL
+//
+// 渲染将引用列限制为枚举值的 CHECK 约束片段
+// form 决定列出哪种值，例如 SQLBasic 得到 CHECK ("status" IN ('unknown', 'success'))
+// 集合没有值时返回 ErrEmptyEnums，因为 IN () 不是合法的 SQL
+func (c *Enums[P, B, M]) SQLCheck(dialect SQLDialect, column string, form SQLForm) (string, error) {
+	if err := checkDialect(dialect); err != nil {
+		return "", err
+	}
+	if len(c.enumElements) == 0 {
+		return "", ErrEmptyEnums
+	}
+	var values = make([]string, 0, len(c.enumElements))
+	for _, enum := range c.enumElements {
+		switch form {
+		case SQLBasic:
+			basic, err := basicLiteral(dialect, enum.Basic())
+			if err != nil {
+				return "", err
+			}
+			values = append(values, basic)
+		case SQLCode:
+			values = append(values, strconv.Itoa(int(enum.Code())))
+		case SQLName:
+			values = append(values, quoteText(dialect, enum.Name()))
+		default:
+			return "", fmt.Errorf("protoenum: unknown sql form %q", form)
+		}
+	}
+	return "CHECK (" + quoteIdent(dialect, column) + " IN (" + strings.Join(values, ", ") + "))", nil
+}
+
+// sqlBasicType returns the column type matching the basic value kind
+// Strings get VARCHAR sized to the longest basic value
+//
+// 返回与 basic 值类型匹配的列类型
+// 字符串使用按最长 basic 值确定长度的 VARCHAR
+func (c *Enums[P, B, M]) sqlBasicType(dialect SQLDialect) (string, error) {
+	var zero B
+	value, err := utils.DriverValue(zero)
+	if err != nil {
+		return "", err
+	}
+	switch value.(type) {
+	case string:
+		var size = 1
+		for _, enum := range c.enumElements {
+			text, err := utils.FormatBasic(enum.Basic())
+			if err != nil {
+				return "", err
+			}
+			size = max(size, utf8.RuneCountInString(text))
+		}
+		return "VARCHAR(" + strconv.Itoa(size) + ")", nil
+	case bool:
+		return "BOOLEAN", nil
+	case int64:
+		return "BIGINT", nil
+	case float64:
+		switch dialect {
+		case DialectPostgres:
+			return "DOUBLE PRECISION", nil
+		case DialectMySQL:
+			return "DOUBLE", nil
+		default:
+			return "REAL", nil
+		}
+	default:
+		return "", fmt.Errorf("protoenum: unsupported basic type %T", zero)
+	}
+}
+
+// basicLiteral renders a basic value as an SQL literal, quoting strings
+//
+// 将 basic 值渲染为 SQL 字面量，字符串会加引号
+func basicLiteral[B comparable](dialect SQLDialect, basic B) (string, error) {
+	value, err := utils.DriverValue(basic)
+	if err != nil {
+		return "", err
+	}
+	switch value := value.(type) {
+	case string:
+		return quoteText(dialect, value), nil
+	case bool:
+		return strings.ToUpper(strconv.FormatBool(value)), nil
+	default:
+		return utils.FormatBasic(basic)
+	}
+}
+
+// checkDialect reports an error when the dialect is not supported
+//
+// 当方言不受支持时返回错误
+func checkDialect(dialect SQLDialect) error {
+	switch dialect {
+	case DialectPostgres, DialectMySQL, DialectSQLite:
+		return nil
+	default:
+		return fmt.Errorf("protoenum: unknown sql dialect %q", dialect)
+	}
+}
+
+// quoteIdent quotes a table or column name in the dialect
+//
+// 按方言引用表名或列名
+func quoteIdent(dialect SQLDialect, name string) string {
+	if dialect == DialectMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteText quotes a string literal in the dialect
+// MySQL treats backslash as an escape char, so it gets doubled there
+//
+// 按方言引用字符串字面量
+// MySQL 将反斜杠视为转义字符，因此需要双写
+func quoteText(dialect SQLDialect, text string) string {
+	if dialect == DialectMySQL {
+		text = strings.ReplaceAll(text, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
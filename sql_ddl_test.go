@@ -0,0 +1,143 @@
+package protoenum_test
+
+import (
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// update rewrites the golden files with the rendered output
+// update 使用渲染的输出重写 golden 文件
+var update = flag.Bool("update", false, "update golden files")
+
+// sqlScript joins the table, seed and check snippets rendered by the enums
+//
+// sqlScript 拼接 enums 渲染的建表、种子数据和约束片段
+func sqlScript[P protoenum.ProtoEnum, B comparable, M any](t *testing.T, enums *protoenum.Enums[P, B, M], dialect protoenum.SQLDialect, table string) string {
+	createTable, err := enums.SQLCreateTable(dialect, table)
+	require.NoError(t, err)
+	insertRows, err := enums.SQLInsertRows(dialect, table)
+	require.NoError(t, err)
+
+	var checks []string
+	for _, form := range []protoenum.SQLForm{protoenum.SQLBasic, protoenum.SQLCode, protoenum.SQLName} {
+		check, err := enums.SQLCheck(dialect, "status", form)
+		require.NoError(t, err)
+		checks = append(checks, "-- "+string(form)+"\n"+check+"\n")
+	}
+	return createTable + "\n" + insertRows + "\n" + strings.Join(checks, "\n")
+}
+
+// TestEnums_SQL_Golden tests the DDL, seed rows and checks of each dialect against golden files
+// Covers StatusEnum with option descriptions and ResultEnum with numeric basics and no descriptions
+//
+// 使用 golden 文件验证各方言的 DDL、种子数据和约束
+// 覆盖带选项描述的 StatusEnum，以及数字 basic 且无描述的 ResultEnum
+func TestEnums_SQL_Golden(t *testing.T) {
+	for _, dialect := range []protoenum.SQLDialect{protoenum.DialectPostgres, protoenum.DialectMySQL, protoenum.DialectSQLite} {
+		t.Run(string(dialect), func(t *testing.T) {
+			scripts := map[string]string{
+				"status_enum": sqlScript(t, protoenum.NewEnumsFromOptions[protoenumstatus.StatusEnum](), dialect, "status_enum"),
+				"result_enum": sqlScript(t, resultEnums, dialect, "result_enum"),
+			}
+			for table, script := range scripts {
+				path := filepath.Join("testdata", table+"."+string(dialect)+".sql")
+				if *update {
+					require.NoError(t, os.WriteFile(path, []byte(script), 0644))
+				}
+				golden, err := os.ReadFile(path)
+				require.NoError(t, err)
+				require.Equal(t, string(golden), script)
+			}
+		})
+	}
+}
+
+// TestEnums_SQL_Quote tests quotes in names and descriptions are escaped in each dialect
+//
+// 验证各方言中名称和描述里的引号被正确转义
+func TestEnums_SQL_Quote(t *testing.T) {
+	enums := protoenum.NewEnums(
+		protoenum.NewEnumWithDesc(protoenumstatus.StatusEnum_UNKNOWN, "it's", `C:\tmp`),
+	)
+
+	rows, err := enums.SQLInsertRows(protoenum.DialectPostgres, `a"b`)
+	require.NoError(t, err)
+	t.Log(rows)
+	require.Contains(t, rows, `INSERT INTO "a""b"`)
+	require.Contains(t, rows, `'it''s', 'C:\tmp'`)
+
+	rows, err = enums.SQLInsertRows(protoenum.DialectMySQL, "a`b")
+	require.NoError(t, err)
+	t.Log(rows)
+	require.Contains(t, rows, "INSERT INTO `a``b`")
+	require.Contains(t, rows, `'it''s', 'C:\\tmp'`)
+}
+
+// TestEnums_SQL_Invalid tests unknown dialects and forms return errors
+//
+// 验证未知的方言和形式返回错误
+func TestEnums_SQL_Invalid(t *testing.T) {
+	enums := newStatusEnums()
+
+	_, err := enums.SQLCreateTable("oracle", "status_enum")
+	require.Error(t, err)
+	t.Log(err)
+
+	_, err = enums.SQLInsertRows("oracle", "status_enum")
+	require.Error(t, err)
+
+	_, err = enums.SQLCheck(protoenum.DialectSQLite, "status", "xml")
+	require.Error(t, err)
+	t.Log(err)
+}
+
+// TestEnums_SQL_Empty tests empty collections return ErrEmptyEnums instead of invalid SQL
+// Checks CREATE TABLE still renders since it needs no values
+//
+// 验证空集合返回 ErrEmptyEnums，而不是生成非法的 SQL
+// 测试 CREATE TABLE 不需要值，仍能正常渲染
+func TestEnums_SQL_Empty(t *testing.T) {
+	enums := protoenum.NewEnums[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]()
+
+	_, err := enums.SQLInsertRows(protoenum.DialectPostgres, "status_enum")
+	require.ErrorIs(t, err, protoenum.ErrEmptyEnums)
+	t.Log(err)
+
+	_, err = enums.SQLCheck(protoenum.DialectPostgres, "status", protoenum.SQLBasic)
+	require.ErrorIs(t, err, protoenum.ErrEmptyEnums)
+
+	table, err := enums.SQLCreateTable(protoenum.DialectPostgres, "status_enum")
+	require.NoError(t, err)
+	t.Log(table)
+}
+
+// TestEnums_SQL_Overflow tests unsigned basic values above math.MaxInt64 return errors instead of wrapping
+// Checks both the seed rows and the stored driver value
+//
+// 验证超过 math.MaxInt64 的无符号 basic 值返回错误而不是回绕
+// 测试种子数据行和存储的驱动值
+func TestEnums_SQL_Overflow(t *testing.T) {
+	type SizeType uint64
+	enums := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, SizeType(0)),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, SizeType(math.MaxUint64)),
+	)
+
+	_, err := enums.SQLInsertRows(protoenum.DialectPostgres, "size_enum")
+	require.Error(t, err)
+	t.Log(err)
+
+	_, err = enums.SQLCheck(protoenum.DialectPostgres, "size", protoenum.SQLBasic)
+	require.Error(t, err)
+
+	_, err = enums.NewSQLValue(protoenum.SQLBasic, enums.GetByCode(1)).Value()
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package protoenum
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// SQLForm names the column form used when storing an Enum in the database
+//
+// SQLForm 表示在数据库中存储 Enum 时使用的列形式
+type SQLForm string
+
+const (
+	SQLBasic SQLForm = "basic" // Store Basic(), e.g. 'success' // 存储 Basic()，例如 'success'
+	SQLCode  SQLForm = "code"  // Store Code(), e.g. 1 // 存储 Code()，例如 1
+	SQLName  SQLForm = "name"  // Store Name(), e.g. 'SUCCESS' // 存储 Name()，例如 'SUCCESS'
+)
+
+// SQLValue adapts an Enum to database/sql using the chosen column form
+// Implements sql.Scanner and driver.Valuer, resolving scanned values through the owning Enums
+// NULL stays distinct from the default: it scans into an absent SQLValue and absent values write NULL
+// Unknown values return *UnknownValueError rather than silently using GetDefault
+//
+// SQLValue 按选定的列形式将 Enum 适配到 database/sql
+// 实现 sql.Scanner 和 driver.Valuer，通过所属的 Enums 解析扫描得到的值
+// NULL 与默认值相互区分：扫描 NULL 得到缺失的 SQLValue，缺失的值写入 NULL
+// 未知值返回 *UnknownValueError，而不是静默使用 GetDefault
+type SQLValue[P ProtoEnum, B comparable, M any] struct {
+	enums *Enums[P, B, M] // Owning collection used when scanning // 扫描时使用的所属集合
+	form  SQLForm         // Column form // 列形式
+	enum  *Enum[P, B, M]  // Held Enum, nil when absent // 持有的 Enum，缺失时为 nil
+}
+
+// NewSQLValue creates an SQLValue of the given column form holding the given Enum
+// Pass nil to write NULL, or to create a destination used with rows.Scan
+//
+// 创建持有给定 Enum 且使用给定列形式的 SQLValue
+// 传入 nil 表示写入 NULL，或创建用于 rows.Scan 的目标
+func (c *Enums[P, B, M]) NewSQLValue(form SQLForm, enum *Enum[P, B, M]) *SQLValue[P, B, M] {
+	return &SQLValue[P, B, M]{enums: c, form: form, enum: enum}
+}
+
+// Enum returns the held Enum, nil when absent
+//
+// 返回持有的 Enum，缺失时为 nil
+func (v *SQLValue[P, B, M]) Enum() *Enum[P, B, M] {
+	return v.enum
+}
+
+// Valid reports whether the SQLValue holds an Enum, false after scanning NULL
+//
+// 判断 SQLValue 是否持有 Enum，扫描 NULL 后为 false
+func (v *SQLValue[P, B, M]) Valid() bool {
+	return v.enum != nil
+}
+
+// Value emits the held Enum in the column form, NULL when absent
+//
+// 以列形式输出持有的 Enum，缺失时为 NULL
+func (v *SQLValue[P, B, M]) Value() (driver.Value, error) {
+	return sqlDriverValue(v.form, v.enum)
+}
+
+// Scan resolves the column value through LookupByBasic, LookupByCode or LookupByName
+// NULL leaves the SQLValue absent, unknown values return *UnknownValueError
+//
+// 通过 LookupByBasic、LookupByCode 或 LookupByName 解析列值
+// NULL 使 SQLValue 为缺失状态，未知值返回 *UnknownValueError
+func (v *SQLValue[P, B, M]) Scan(src any) error {
+	enum, err := v.enums.scanSQL(v.form, src)
+	v.enum = enum
+	return err
+}
+
+// sqlDriverValue emits the Enum in the column form, NULL when nil
+// Shared by SQLValue and Value so both write the same column values
+//
+// 以列形式输出 Enum，为 nil 时输出 NULL
+// SQLValue 和 Value 共用此函数，使两者写入相同的列值
+func sqlDriverValue[P ProtoEnum, B comparable, M any](form SQLForm, enum *Enum[P, B, M]) (driver.Value, error) {
+	if enum == nil {
+		return nil, nil
+	}
+	switch form {
+	case SQLBasic:
+		return utils.DriverValue(enum.Basic())
+	case SQLCode:
+		return int64(enum.Code()), nil
+	case SQLName:
+		return enum.Name(), nil
+	default:
+		return nil, fmt.Errorf("protoenum: unknown sql form %q", form)
+	}
+}
+
+// scanSQL resolves the column value in the column form, returns nil without error on NULL
+// Shared by SQLValue and Value so both keep the same NULL and unknown value semantics
+//
+// 按列形式解析列值，NULL 时返回 nil 且不返回错误
+// SQLValue 和 Value 共用此函数，使两者保持相同的 NULL 和未知值语义
+func (c *Enums[P, B, M]) scanSQL(form SQLForm, src any) (*Enum[P, B, M], error) {
+	if src == nil {
+		return nil, nil
+	}
+	text, err := utils.DriverText(src)
+	if err != nil {
+		return nil, err
+	}
+	switch form {
+	case SQLBasic:
+		return c.parseBasicText(text)
+	case SQLCode:
+		code, err := strconv.ParseInt(text, 10, 32)
+		if err != nil {
+			return nil, c.newUnknownValueError(LookupCode, text)
+		}
+		return c.ParseByCode(int32(code))
+	case SQLName:
+		return c.ParseByName(text)
+	default:
+		return nil, fmt.Errorf("protoenum: unknown sql form %q", form)
+	}
+}
+
+// parseBasicText resolves the basic value text, returns *UnknownValueError when the text is not a basic value
+//
+// 解析 basic 值文本，文本不是 basic 值时返回 *UnknownValueError
+func (c *Enums[P, B, M]) parseBasicText(text string) (*Enum[P, B, M], error) {
+	basic, err := utils.ParseBasic[B](text)
+	if err != nil {
+		return nil, c.newUnknownValueError(LookupBasic, text)
+	}
+	return c.ParseByBasic(basic)
+}
@@ -0,0 +1,173 @@
+package protoenum_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver is an in-memory database/sql driver storing one column of rows
+// Exec appends the single argument as a row, Query returns the stored rows
+//
+// fakeDriver 是在内存中存储单列数据的 database/sql 驱动
+// Exec 将单个参数追加为一行，Query 返回已存储的行
+type fakeDriver struct {
+	mutex sync.Mutex
+	rows  []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{conn: c}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ conn *fakeConn }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+	s.conn.driver.rows = append(s.conn.driver.rows, args...)
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+	return &fakeRows{values: append([]driver.Value{}, s.conn.driver.rows...)}, nil
+}
+
+type fakeRows struct{ values []driver.Value }
+
+func (r *fakeRows) Columns() []string { return []string{"status"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+// fakeDatabases counts registered fake drivers so each test gets a clean one
+// fakeDatabases 统计已注册的驱动数量，使每个测试使用独立的驱动
+var fakeDatabases = 0
+
+// openFakeDatabase registers a fresh fake driver and opens a database on it
+//
+// openFakeDatabase 注册新的驱动并在其上打开数据库
+func openFakeDatabase(t *testing.T) (*sql.DB, *fakeDriver) {
+	fakeDatabases++
+	name := "protoenum-fake-" + strconv.Itoa(fakeDatabases)
+	fake := &fakeDriver{}
+	sql.Register(name, fake)
+
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+	return db, fake
+}
+
+// scanAll reads each stored row through SQLValue destinations of the given form
+//
+// scanAll 使用给定形式的 SQLValue 读取各存储行
+func scanAll(t *testing.T, db *sql.DB, form protoenum.SQLForm) ([]*protoenum.SQLValue[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc], error) {
+	rows, err := db.Query("SELECT status FROM tasks")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, rows.Close()) }()
+
+	var results []*protoenum.SQLValue[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]
+	for rows.Next() {
+		value := statusEnums.NewSQLValue(form, nil)
+		if err := rows.Scan(value); err != nil {
+			return results, err
+		}
+		results = append(results, value)
+	}
+	return results, rows.Err()
+}
+
+// TestSQLValue_Forms tests each column form round-trips through the fake driver
+// Checks the written driver values and the scanned Enums, NULL included
+//
+// 验证各列形式都能通过驱动往返
+// 测试写入的驱动值和扫描得到的 Enum，包括 NULL
+func TestSQLValue_Forms(t *testing.T) {
+	testCases := []struct {
+		form     protoenum.SQLForm
+		expected []driver.Value
+	}{
+		{form: protoenum.SQLBasic, expected: []driver.Value{"success", "unknown", nil}},
+		{form: protoenum.SQLCode, expected: []driver.Value{int64(1), int64(0), nil}},
+		{form: protoenum.SQLName, expected: []driver.Value{"SUCCESS", "UNKNOWN", nil}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.form), func(t *testing.T) {
+			db, fake := openFakeDatabase(t)
+
+			for _, enum := range []*protoenum.Enum[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]{
+				statusEnums.GetByCode(1),
+				statusEnums.GetByCode(0),
+				nil,
+			} {
+				_, err := db.Exec("INSERT INTO tasks (status) VALUES (?)", statusEnums.NewSQLValue(tc.form, enum))
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, fake.rows)
+
+			results, err := scanAll(t, db, tc.form)
+			require.NoError(t, err)
+			require.Len(t, results, 3)
+			require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, results[0].Enum().Proto())
+			require.Equal(t, protoenumstatus.StatusEnum_UNKNOWN, results[1].Enum().Proto())
+			// NULL is distinct from the default // NULL 与默认值相互区分
+			require.True(t, results[1].Valid())
+			require.False(t, results[2].Valid())
+			require.Nil(t, results[2].Enum())
+		})
+	}
+}
+
+// TestSQLValue_Unknown tests unknown column values return UnknownValueError
+//
+// 验证未知的列值返回 UnknownValueError
+func TestSQLValue_Unknown(t *testing.T) {
+	testCases := []struct {
+		form  protoenum.SQLForm
+		value driver.Value
+		kind  protoenum.LookupKind
+	}{
+		{form: protoenum.SQLBasic, value: "done", kind: protoenum.LookupBasic},
+		{form: protoenum.SQLCode, value: int64(9), kind: protoenum.LookupCode},
+		{form: protoenum.SQLCode, value: "x", kind: protoenum.LookupCode},
+		{form: protoenum.SQLName, value: "DONE", kind: protoenum.LookupName},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.form), func(t *testing.T) {
+			db, fake := openFakeDatabase(t)
+			fake.rows = []driver.Value{tc.value}
+
+			_, err := scanAll(t, db, tc.form)
+			require.Error(t, err)
+			t.Log(err)
+
+			var unknownValueError *protoenum.UnknownValueError
+			require.True(t, errors.As(err, &unknownValueError))
+			require.Equal(t, tc.kind, unknownValueError.Kind)
+		})
+	}
+}
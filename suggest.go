@@ -0,0 +1,73 @@
+package protoenum
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// maxSuggestions limits the candidates returned by Suggest
+//
+// maxSuggestions 限制 Suggest 返回的候选数量
+const maxSuggestions = 3
+
+// Suggest ranks the registered names or basic values closest to the input, e.g. sucess -> [success]
+// Candidates sharing a prefix with the input, or within a small edit distance, are kept
+// Ranked by edit distance ignoring case, then by defined sequence, at most three are returned
+// Returns nil with LookupCode and LookupProto, numbers have no meaningful spelling distance
+//
+// 对与输入最接近的已注册名称或 basic 值进行排序，例如 sucess -> [success]
+// 保留与输入有共同前缀或编辑距离较小的候选
+// 按忽略大小写的编辑距离排序，其次按定义次序，最多返回三个
+// 使用 LookupCode 和 LookupProto 时返回 nil，数字没有有意义的拼写距离
+func (c *Enums[P, B, M]) Suggest(kind LookupKind, input string) []string {
+	var candidates []string
+	switch kind {
+	case LookupName:
+		for _, item := range c.enumElements {
+			candidates = append(candidates, item.Name())
+		}
+		candidates = append(candidates, c.aliasNames...)
+	case LookupBasic:
+		for _, item := range c.enumElements {
+			candidates = append(candidates, fmt.Sprint(item.Basic()))
+		}
+	default:
+		return nil
+	}
+
+	type ranked struct {
+		text     string
+		distance int
+	}
+	var target = strings.ToLower(input)
+	var threshold = max(1, len([]rune(target))/3)
+	var results []ranked
+	for _, candidate := range candidates {
+		text := strings.ToLower(candidate)
+		// Skip blank candidates, e.g. a zero basic, since each input would start with them
+		// 跳过空白候选，例如零值 basic，因为任何输入都以其开头
+		if text == "" {
+			continue
+		}
+		distance := utils.EditDistance(target, text)
+		prefix := target != "" && (strings.HasPrefix(text, target) || strings.HasPrefix(target, text))
+		if distance <= threshold || prefix {
+			results = append(results, ranked{text: candidate, distance: distance})
+		}
+	}
+	slices.SortStableFunc(results, func(a, b ranked) int {
+		return a.distance - b.distance
+	})
+
+	var res []string
+	for _, item := range results {
+		if len(res) == maxSuggestions {
+			break
+		}
+		res = append(res, item.text)
+	}
+	return res
+}
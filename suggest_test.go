@@ -0,0 +1,60 @@
+package protoenum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEnums_Suggest tests names and basic values are ranked by edit distance and prefix
+// Checks a blank basic value is never suggested
+//
+// 验证名称和 basic 值按编辑距离和前缀排序
+// 测试空白的 basic 值不会被推荐
+func TestEnums_Suggest(t *testing.T) {
+	enums := newStatusEnums().WithAliasNames(protoenumstatus.StatusEnum_SUCCESS, "SUCCEEDED")
+
+	require.Equal(t, []string{"success"}, enums.Suggest(protoenum.LookupBasic, "sucess"))
+	require.Equal(t, []string{"failure"}, enums.Suggest(protoenum.LookupBasic, "failrue"))
+	require.Equal(t, []string{"SUCCESS", "SUCCEEDED"}, enums.Suggest(protoenum.LookupName, "succ"))
+	require.Equal(t, []string{"SUCCEEDED"}, enums.Suggest(protoenum.LookupName, "succeded"))
+	require.Equal(t, []string{"UNKNOWN"}, enums.Suggest(protoenum.LookupName, "unkown"))
+	require.Empty(t, enums.Suggest(protoenum.LookupName, "DONE"))
+	require.Empty(t, enums.Suggest(protoenum.LookupName, ""))
+	require.Nil(t, enums.Suggest(protoenum.LookupCode, "1"))
+
+	blank := protoenum.NewEnums(
+		protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, ""),
+		protoenum.NewEnum(protoenumstatus.StatusEnum_SUCCESS, "success"),
+	)
+	require.Empty(t, blank.Suggest(protoenum.LookupBasic, "done"))
+	require.Empty(t, blank.Suggest(protoenum.LookupBasic, "x"))
+	require.Equal(t, []string{"success"}, blank.Suggest(protoenum.LookupBasic, "sucess"))
+}
+
+// TestEnums_ParseByBasic_Suggest tests parse errors carry the closest candidates
+//
+// 验证解析错误携带最接近的候选
+func TestEnums_ParseByBasic_Suggest(t *testing.T) {
+	enums := newStatusEnums()
+
+	_, err := enums.ParseByBasic("sucess")
+	require.Error(t, err)
+	t.Log(err)
+	require.Equal(t, `protoenum: invalid basic "sucess" of protoenumstatus.StatusEnum, expected one of [unknown success failure], did you mean [success]`, err.Error())
+
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, []string{"success"}, unknownValueError.Suggestions)
+
+	_, err = enums.ParseJSON([]byte(`"Failur"`), protoenum.JSONBasic)
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, []string{"failure"}, unknownValueError.Suggestions)
+
+	_, err = enums.ParseByCode(9)
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Empty(t, unknownValueError.Suggestions)
+}
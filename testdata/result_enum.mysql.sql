@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `result_enum` (
+    `code` INTEGER NOT NULL PRIMARY KEY,
+    `name` VARCHAR(7) NOT NULL UNIQUE,
+    `basic` BIGINT NOT NULL UNIQUE,
+    `description` TEXT
+);
+
+INSERT INTO `result_enum` (`code`, `name`, `basic`, `description`) VALUES
+    (0, 'UNKNOWN', 0, NULL),
+    (1, 'PASS', 10, NULL),
+    (2, 'MISS', 20, NULL),
+    (3, 'SKIP', 30, NULL);
+
+-- basic
+CHECK (`status` IN (0, 10, 20, 30))
+
+-- code
+CHECK (`status` IN (0, 1, 2, 3))
+
+-- name
+CHECK (`status` IN ('UNKNOWN', 'PASS', 'MISS', 'SKIP'))
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `status_enum` (
+    `code` INTEGER NOT NULL PRIMARY KEY,
+    `name` VARCHAR(7) NOT NULL UNIQUE,
+    `basic` VARCHAR(7) NOT NULL UNIQUE,
+    `description` TEXT
+);
+
+INSERT INTO `status_enum` (`code`, `name`, `basic`, `description`) VALUES
+    (0, 'UNKNOWN', 'unknown', 'Status unknown'),
+    (1, 'SUCCESS', 'success', 'Operation succeeded'),
+    (2, 'FAILURE', 'failure', 'Operation failed');
+
+-- basic
+CHECK (`status` IN ('unknown', 'success', 'failure'))
+
+-- code
+CHECK (`status` IN (0, 1, 2))
+
+-- name
+CHECK (`status` IN ('UNKNOWN', 'SUCCESS', 'FAILURE'))
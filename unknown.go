@@ -0,0 +1,161 @@
+package protoenum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IsUnknown reports whether the Enum stands for a number not registered in the collection
+// Unknown enums come from GetOrUnknownByXxx, they keep the number but hold zero basic and meta values
+// Having no basic value, they marshal to JSON as the number, see MarshalJSON and ParseJSONOrUnknown
+//
+// 判断 Enum 是否表示集合中未注册的数字
+// 未知枚举来自 GetOrUnknownByXxx，保留原始数字，basic 和元数据为零值
+// 由于没有 basic 值，它们序列化为 JSON 数字，参见 MarshalJSON 和 ParseJSONOrUnknown
+func (c *Enum[protoEnum, basicEnum, metaType]) IsUnknown() bool {
+	return c.unknown
+}
+
+// GetOrUnknownByCode finds an Enum using its numeric code, keeping unknown numbers instead of using the default
+// Proto3 enums are open, so a newer peer can send numbers this build does not know
+// On a miss returns a fresh unknown Enum: Proto() is P(code), IsUnknown() is true, Name() follows Enum.Name
+// The result is distinct from the default, so re-serialization keeps the original number
+// Returns ErrUnknownNumber on a miss when P cannot hold the number, e.g. a struct type
+//
+// 通过数字代码检索 Enum，未知数字会被保留而不是使用默认值
+// Proto3 枚举是开放的，较新的对端可能发送当前构建未知的数字
+// 查找失败时返回新的未知 Enum：Proto() 为 P(code)，IsUnknown() 为 true，Name() 遵循 Enum.Name
+// 与默认值是不同的实例，因此重新序列化时保留原始数字
+// 查找失败且 P 无法持有该数字时返回 ErrUnknownNumber，例如结构体类型
+func (c *Enums[P, B, M]) GetOrUnknownByCode(code int32) (*Enum[P, B, M], error) {
+	if res, ok := c.findByCode(code); ok {
+		return res, nil
+	}
+	proto, err := c.protoFromNumber(protoreflect.EnumNumber(code))
+	if err != nil {
+		return nil, err
+	}
+	return newUnknownEnum[P, B, M](proto), nil
+}
+
+// GetOrUnknownByProto finds an Enum using its proto enum, keeping unknown values instead of using the default
+// On a miss returns a fresh unknown Enum holding the given proto, see GetOrUnknownByCode
+//
+// 通过 proto 枚举检索 Enum，未知值会被保留而不是使用默认值
+// 查找失败时返回持有给定 proto 的新未知 Enum，参见 GetOrUnknownByCode
+func (c *Enums[P, B, M]) GetOrUnknownByProto(proto P) *Enum[P, B, M] {
+	if res, ok := c.findByProto(proto); ok {
+		return res
+	}
+	return newUnknownEnum[P, B, M](proto)
+}
+
+// ParseJSONOrUnknown finds the Enum matching JSON data in the given shape, keeping unknown numbers
+// Works like ParseJSON, but numbers matching no enum become the unknown Enum of GetOrUnknownByCode
+// Numbers are read from the code and object shapes, from numeric or declared names, and from JSON numbers in the basic shape
+// This reads back what MarshalJSON and MarshalJSONFormat emit on unknown enums
+//
+// 查找与给定形式 JSON 数据匹配的 Enum，并保留未知数字
+// 与 ParseJSON 相同，但未匹配任何枚举的数字会成为 GetOrUnknownByCode 的未知 Enum
+// 数字取自代码和对象形式、数字形式或描述符中声明的名称，以及 basic 形式中的 JSON 数字
+// 用于读回 MarshalJSON 和 MarshalJSONFormat 对未知枚举的输出
+func (c *Enums[P, B, M]) ParseJSONOrUnknown(data []byte, format JSONFormat) (*Enum[P, B, M], error) {
+	res, err := c.ParseJSON(data, format)
+	if err == nil {
+		return res, nil
+	}
+	code, ok := c.jsonUnknownCode(data, format)
+	if !ok {
+		return nil, err
+	}
+	return c.GetOrUnknownByCode(code)
+}
+
+// jsonUnknownCode reads the number of an unknown enum out of JSON data in the given shape
+// Names declared in the descriptor but missing from the collection resolve to their number
+//
+// jsonUnknownCode 从给定形式的 JSON 数据中读取未知枚举的数字
+// 描述符中声明但集合中缺少的名称解析为其数字
+func (c *Enums[P, B, M]) jsonUnknownCode(data []byte, format JSONFormat) (int32, bool) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return 0, false
+	}
+	switch format {
+	case JSONBasic, JSONCode:
+		var code int32
+		if err := json.Unmarshal(data, &code); err != nil {
+			return 0, false
+		}
+		return code, true
+	case JSONName:
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return 0, false
+		}
+		if c.descriptor != nil {
+			if value := c.descriptor.Values().ByName(protoreflect.Name(name)); value != nil {
+				return int32(value.Number()), true
+			}
+		}
+		code, err := strconv.ParseInt(name, 10, 32)
+		if err != nil {
+			return 0, false
+		}
+		return int32(code), true
+	case JSONObject:
+		var object struct {
+			Code *int32 `json:"code"`
+		}
+		if err := json.Unmarshal(data, &object); err != nil || object.Code == nil {
+			return 0, false
+		}
+		return *object.Code, true
+	default:
+		return 0, false
+	}
+}
+
+// newUnknownEnum creates an unknown Enum holding the proto with zero basic and meta values
+// Pointer meta types get a fresh zero value instead of nil, so Meta().Desc() stays safe
+//
+// newUnknownEnum 创建持有该 proto 的未知 Enum，basic 和元数据为零值
+// 指针类型的元数据使用新建的零值而不是 nil，使 Meta().Desc() 保持安全
+func newUnknownEnum[P ProtoEnum, B comparable, M any](proto P) *Enum[P, B, M] {
+	var meta M
+	if rt := reflect.TypeOf(&meta).Elem(); rt.Kind() == reflect.Pointer {
+		meta = reflect.New(rt.Elem()).Interface().(M)
+	}
+	return &Enum[P, B, M]{proto: proto, meta: meta, unknown: true}
+}
+
+// protoFromNumber creates the protoEnum value of type P with the given number
+// Uses the descriptor when P is a generated enum or DynamicProto, otherwise sets the number on an integer P
+// Returns ErrUnknownNumber when P is neither a generated enum, DynamicProto nor an integer type able to hold the number
+//
+// 使用给定数字创建类型 P 的 protoEnum 值
+// P 为生成的枚举或 DynamicProto 时使用描述符，否则在整数类型的 P 上设置该数字
+// 当 P 既不是生成的枚举、DynamicProto，也不是能容纳该数字的整数类型时返回 ErrUnknownNumber
+func (c *Enums[P, B, M]) protoFromNumber(number protoreflect.EnumNumber) (P, error) {
+	if _, ok := enumDescriptor[P](); ok {
+		return newProtoEnum[P](number), nil
+	}
+	if res, ok := any(NewDynamicProto(c.descriptor, number)).(P); ok {
+		return res, nil
+	}
+	var res P
+	rv := reflect.ValueOf(&res).Elem()
+	switch {
+	case rv.CanInt() && !rv.OverflowInt(int64(number)):
+		rv.SetInt(int64(number))
+	case rv.CanUint() && number >= 0 && !rv.OverflowUint(uint64(number)):
+		rv.SetUint(uint64(number))
+	default:
+		return res, fmt.Errorf("%w: %T(%d)", ErrUnknownNumber, res, number)
+	}
+	return res, nil
+}
@@ -0,0 +1,138 @@
+package protoenum_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestEnums_GetOrUnknownByCode tests unknown numbers are kept instead of collapsing to the default
+// Checks the unknown Enum keeps the number, names it by the number and is not the default
+//
+// 验证未知数字被保留，而不是折叠为默认值
+// 测试未知 Enum 保留数字、以数字命名且不是默认值
+func TestEnums_GetOrUnknownByCode(t *testing.T) {
+	enums := newStatusEnums()
+
+	enum, err := enums.GetOrUnknownByCode(1)
+	require.NoError(t, err)
+	require.False(t, enum.IsUnknown())
+	require.Same(t, enums.GetByCode(1), enum)
+
+	enum, err = enums.GetOrUnknownByCode(7)
+	require.NoError(t, err)
+	require.True(t, enum.IsUnknown())
+	require.Equal(t, protoenumstatus.StatusEnum(7), enum.Proto())
+	require.Equal(t, int32(7), enum.Code())
+	require.Equal(t, "7", enum.Name())
+	require.Equal(t, "", enum.Basic())
+	require.Equal(t, "", enum.Meta().Desc())
+	require.NotSame(t, enums.GetDefault(), enum)
+	require.False(t, enums.GetDefault().IsUnknown())
+}
+
+// TestEnums_GetOrUnknownByProto tests unknown protos and plain enums without descriptors
+//
+// 验证未知 proto 以及没有描述符的普通枚举
+func TestEnums_GetOrUnknownByProto(t *testing.T) {
+	enum := newStatusEnums().GetOrUnknownByProto(protoenumstatus.StatusEnum(-3))
+	require.True(t, enum.IsUnknown())
+	require.Equal(t, "-3", enum.Name())
+
+	plain := protoenum.NewEnums(protoenum.NewEnum(plainEnum(0), "zero"))
+	enum2, err := plain.GetOrUnknownByCode(5)
+	require.NoError(t, err)
+	require.True(t, enum2.IsUnknown())
+	require.Equal(t, plainEnum(5), enum2.Proto())
+	require.NotNil(t, enum2.Meta())
+}
+
+// TestEnums_GetOrUnknownByProto_Declared tests unknown values declared in the descriptor keep their proto name
+// Checks the name shape of JSON reads such values back as unknown Enums
+//
+// 验证描述符中声明但集合中缺少的值保留其 proto 名称
+// 测试 JSON 名称形式能将这类值读回为未知 Enum
+func TestEnums_GetOrUnknownByProto_Declared(t *testing.T) {
+	enums := protoenum.NewEnums(protoenum.NewEnum(protoenumstatus.StatusEnum_UNKNOWN, "u"))
+	enum := enums.GetOrUnknownByProto(protoenumstatus.StatusEnum_SUCCESS)
+	require.True(t, enum.IsUnknown())
+	require.Equal(t, "SUCCESS", enum.Name())
+
+	data, err := enum.MarshalJSONFormat(protoenum.JSONName)
+	require.NoError(t, err)
+	require.JSONEq(t, `"SUCCESS"`, string(data))
+
+	res, err := enums.ParseJSONOrUnknown(data, protoenum.JSONName)
+	require.NoError(t, err)
+	require.True(t, res.IsUnknown())
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, res.Proto())
+}
+
+// TestEnums_GetOrUnknownByCode_JSON tests unknown enums round-trip through JSON keeping the number
+// Checks each JSON shape reads back an unknown Enum holding the same number
+//
+// 验证未知枚举经过 JSON 往返后保留数字
+// 测试各种 JSON 形式都能读回持有相同数字的未知 Enum
+func TestEnums_GetOrUnknownByCode_JSON(t *testing.T) {
+	enums := newStatusEnums()
+	unknown, err := enums.GetOrUnknownByCode(7)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(unknown)
+	require.NoError(t, err)
+	require.JSONEq(t, `7`, string(data))
+
+	for _, format := range []protoenum.JSONFormat{protoenum.JSONBasic, protoenum.JSONName, protoenum.JSONCode, protoenum.JSONObject} {
+		data, err := unknown.MarshalJSONFormat(format)
+		require.NoError(t, err)
+		t.Log(format, string(data))
+
+		_, err = enums.ParseJSON(data, format)
+		require.Error(t, err)
+
+		res, err := enums.ParseJSONOrUnknown(data, format)
+		require.NoError(t, err)
+		require.True(t, res.IsUnknown())
+		require.Equal(t, protoenumstatus.StatusEnum(7), res.Proto())
+	}
+
+	res, err := enums.ParseJSONOrUnknown([]byte(`"success"`), protoenum.JSONBasic)
+	require.NoError(t, err)
+	require.Same(t, enums.GetByCode(1), res)
+
+	_, err = enums.ParseJSONOrUnknown([]byte(`"done"`), protoenum.JSONBasic)
+	require.Error(t, err)
+	_, err = enums.ParseJSONOrUnknown([]byte(`null`), protoenum.JSONCode)
+	require.Error(t, err)
+}
+
+// structEnum is a ProtoEnum backed by a struct, unable to hold unknown numbers
+//
+// structEnum 是基于结构体的 ProtoEnum，无法持有未知数字
+type structEnum struct{ number int32 }
+
+func (e structEnum) String() string                  { return "S" + strconv.Itoa(int(e.number)) }
+func (e structEnum) Number() protoreflect.EnumNumber { return protoreflect.EnumNumber(e.number) }
+
+// TestEnums_GetOrUnknownByCode_NoNumber tests ErrUnknownNumber when P cannot hold the unknown number
+//
+// 验证 P 无法持有未知数字时返回 ErrUnknownNumber
+func TestEnums_GetOrUnknownByCode_NoNumber(t *testing.T) {
+	enums := protoenum.NewEnums(protoenum.NewEnum(structEnum{number: 0}, "zero"))
+
+	enum, err := enums.GetOrUnknownByCode(0)
+	require.NoError(t, err)
+	require.Equal(t, "zero", enum.Basic())
+
+	_, err = enums.GetOrUnknownByCode(5)
+	require.ErrorIs(t, err, protoenum.ErrUnknownNumber)
+	t.Log(err)
+
+	_, err = enums.ResolveByCode(5)
+	require.ErrorIs(t, err, protoenum.ErrUnknownNumber)
+}
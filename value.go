@@ -0,0 +1,158 @@
+package protoenum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/go-xlan/protoenum/internal/utils"
+)
+
+// EnumsHolder provides the Enums collection a Value binds to
+// Implement it on a zero-size struct so zero Values still know their collection
+//
+// EnumsHolder 提供 Value 绑定的 Enums 集合
+// 在零大小结构体上实现，使零值 Value 也能找到其集合
+//
+// Example:
+//
+//	type StatusEnums struct{}
+//
+//	func (StatusEnums) Enums() *protoenum.Enums[protoenumstatus.StatusEnum, StatusType, *protoenum.MetaNone] {
+//		return enums
+//	}
+//
+//	type Status = protoenum.Value[StatusEnums, protoenumstatus.StatusEnum, StatusType, *protoenum.MetaNone]
+type EnumsHolder[P ProtoEnum, B comparable, M any] interface {
+	Enums() *Enums[P, B, M]
+}
+
+// Value is a struct field type holding an Enum resolved through the collection of H
+// Implements encoding.TextMarshaler/TextUnmarshaler, json.Marshaler/Unmarshaler, sql.Scanner and driver.Valuer
+// Each form stores the basic value, the zero Value stands for absent and maps to null, blank text and SQL NULL
+// Unknown inputs return *UnknownValueError instead of falling back to the default
+//
+// Value 是结构体字段类型，持有通过 H 的集合解析得到的 Enum
+// 实现 encoding.TextMarshaler/TextUnmarshaler、json.Marshaler/Unmarshaler、sql.Scanner 和 driver.Valuer
+// 各种形式均存储 basic 值，零值 Value 表示缺失，对应 null、空文本和 SQL NULL
+// 未知输入返回 *UnknownValueError，而不是回退到默认值
+type Value[H EnumsHolder[P, B, M], P ProtoEnum, B comparable, M any] struct {
+	enum *Enum[P, B, M] // Resolved Enum, nil when absent // 解析得到的 Enum，缺失时为 nil
+}
+
+// NewValue creates a Value holding the given Enum
+// Pass H explicitly, the remaining type params are inferred, e.g. NewValue[StatusEnums](enum)
+//
+// 创建持有给定 Enum 的 Value
+// 需显式传入 H，其余类型参数可自动推断，例如 NewValue[StatusEnums](enum)
+func NewValue[H EnumsHolder[P, B, M], P ProtoEnum, B comparable, M any](enum *Enum[P, B, M]) Value[H, P, B, M] {
+	return Value[H, P, B, M]{enum: enum}
+}
+
+// Enums returns the collection the Value binds to
+//
+// 返回 Value 绑定的集合
+func (v Value[H, P, B, M]) Enums() *Enums[P, B, M] {
+	var holder H
+	return holder.Enums()
+}
+
+// Enum returns the held Enum, nil when absent
+//
+// 返回持有的 Enum，缺失时为 nil
+func (v Value[H, P, B, M]) Enum() *Enum[P, B, M] {
+	return v.enum
+}
+
+// Valid reports whether the Value holds an Enum
+//
+// 判断 Value 是否持有 Enum
+func (v Value[H, P, B, M]) Valid() bool {
+	return v.enum != nil
+}
+
+// MarshalText emits the basic value as text, blank text when absent
+//
+// 以文本输出 basic 值，缺失时为空文本
+func (v Value[H, P, B, M]) MarshalText() ([]byte, error) {
+	if v.enum == nil {
+		return []byte{}, nil
+	}
+	text, err := utils.FormatBasic(v.enum.Basic())
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
+
+// UnmarshalText resolves the basic value text, blank text leaves the Value absent
+//
+// 解析 basic 值文本，空文本使 Value 为缺失状态
+func (v *Value[H, P, B, M]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		v.enum = nil
+		return nil
+	}
+	return v.parseText(string(text))
+}
+
+// MarshalJSON emits the basic value, null when absent
+//
+// 输出 basic 值，缺失时为 null
+func (v Value[H, P, B, M]) MarshalJSON() ([]byte, error) {
+	if v.enum == nil {
+		return []byte("null"), nil
+	}
+	return v.enum.MarshalJSON()
+}
+
+// UnmarshalJSON resolves the basic value, null leaves the Value absent
+//
+// 解析 basic 值，null 使 Value 为缺失状态
+func (v *Value[H, P, B, M]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		v.enum = nil
+		return nil
+	}
+	var basic B
+	if err := json.Unmarshal(data, &basic); err != nil {
+		return err
+	}
+	enum, err := v.Enums().ParseByBasic(basic)
+	if err != nil {
+		return err
+	}
+	v.enum = enum
+	return nil
+}
+
+// Scan resolves the basic value read from the database, NULL leaves the Value absent
+// Works the same as SQLValue in the SQLBasic column form
+//
+// 解析从数据库读取的 basic 值，NULL 使 Value 为缺失状态
+// 与 SQLBasic 列形式的 SQLValue 行为一致
+func (v *Value[H, P, B, M]) Scan(src any) error {
+	enum, err := v.Enums().scanSQL(SQLBasic, src)
+	v.enum = enum
+	return err
+}
+
+// Value emits the basic value written to the database, NULL when absent
+// Works the same as SQLValue in the SQLBasic column form
+//
+// 输出写入数据库的 basic 值，缺失时为 NULL
+// 与 SQLBasic 列形式的 SQLValue 行为一致
+func (v Value[H, P, B, M]) Value() (driver.Value, error) {
+	return sqlDriverValue(SQLBasic, v.enum)
+}
+
+// parseText resolves the basic value text through the bound collection
+//
+// 通过绑定的集合解析 basic 值文本
+func (v *Value[H, P, B, M]) parseText(text string) error {
+	enum, err := v.Enums().parseBasicText(text)
+	if err != nil {
+		return err
+	}
+	v.enum = enum
+	return nil
+}
@@ -0,0 +1,199 @@
+package protoenum_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-xlan/protoenum"
+	"github.com/go-xlan/protoenum/protos/protoenumresult"
+	"github.com/go-xlan/protoenum/protos/protoenumstatus"
+	"github.com/stretchr/testify/require"
+)
+
+// statusEnums is the StatusEnum collection bound by the Status value type
+// statusEnums 是 Status 值类型绑定的 StatusEnum 集合
+var statusEnums = newStatusEnums()
+
+// StatusEnumsHolder binds the Status value type with statusEnums
+// StatusEnumsHolder 将 Status 值类型与 statusEnums 绑定
+type StatusEnumsHolder struct{}
+
+func (StatusEnumsHolder) Enums() *protoenum.Enums[protoenumstatus.StatusEnum, string, *protoenum.MetaDesc] {
+	return statusEnums
+}
+
+// Status is the struct field type of StatusEnum
+// Status 是 StatusEnum 的结构体字段类型
+type Status = protoenum.Value[StatusEnumsHolder, protoenumstatus.StatusEnum, string, *protoenum.MetaDesc]
+
+// ResultLevel is a numeric Go native enum of ResultEnum
+// ResultLevel 是 ResultEnum 的数字型 Go 原生枚举
+type ResultLevel int
+
+// resultEnums is the ResultEnum collection with numeric basic values
+// resultEnums 是带数字 basic 值的 ResultEnum 集合
+var resultEnums = protoenum.NewEnums(
+	protoenum.NewEnum(protoenumresult.ResultEnum_UNKNOWN, ResultLevel(0)),
+	protoenum.NewEnum(protoenumresult.ResultEnum_PASS, ResultLevel(10)),
+	protoenum.NewEnum(protoenumresult.ResultEnum_MISS, ResultLevel(20)),
+	protoenum.NewEnum(protoenumresult.ResultEnum_SKIP, ResultLevel(30)),
+)
+
+// ResultEnumsHolder binds the Result value type with resultEnums
+// ResultEnumsHolder 将 Result 值类型与 resultEnums 绑定
+type ResultEnumsHolder struct{}
+
+func (ResultEnumsHolder) Enums() *protoenum.Enums[protoenumresult.ResultEnum, ResultLevel, *protoenum.MetaNone] {
+	return resultEnums
+}
+
+// Result is the struct field type of ResultEnum
+// Result 是 ResultEnum 的结构体字段类型
+type Result = protoenum.Value[ResultEnumsHolder, protoenumresult.ResultEnum, ResultLevel, *protoenum.MetaNone]
+
+// Check Value implements the adapter interfaces
+// 检查 Value 实现各适配接口
+var (
+	_ encoding.TextMarshaler   = Status{}
+	_ encoding.TextUnmarshaler = &Status{}
+	_ json.Marshaler           = Status{}
+	_ json.Unmarshaler         = &Status{}
+	_ sql.Scanner              = &Status{}
+	_ driver.Valuer            = Status{}
+)
+
+// TestValue_JSON tests a Value struct field round-trips through JSON
+// Checks the zero Value maps to null and null unmarshals to the zero Value
+//
+// 验证 Value 结构体字段通过 JSON 往返
+// 测试零值 Value 对应 null，null 反序列化为零值 Value
+func TestValue_JSON(t *testing.T) {
+	type Task struct {
+		Status Status `json:"status"`
+		Result Result `json:"result"`
+	}
+
+	task := Task{
+		Status: protoenum.NewValue[StatusEnumsHolder](statusEnums.GetByCode(1)),
+	}
+	data, err := json.Marshal(&task)
+	require.NoError(t, err)
+	t.Log(string(data))
+	require.JSONEq(t, `{"status":"success","result":null}`, string(data))
+
+	var res Task
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"failure","result":20}`), &res))
+	require.True(t, res.Status.Valid())
+	require.Equal(t, protoenumstatus.StatusEnum_FAILURE, res.Status.Enum().Proto())
+	require.Equal(t, protoenumresult.ResultEnum_MISS, res.Result.Enum().Proto())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"status":null}`), &res))
+	require.False(t, res.Status.Valid())
+	require.Nil(t, res.Status.Enum())
+}
+
+// TestValue_UnmarshalJSON_Unknown tests unknown values return UnknownValueError
+//
+// 验证未知值返回 UnknownValueError
+func TestValue_UnmarshalJSON_Unknown(t *testing.T) {
+	var status Status
+	err := json.Unmarshal([]byte(`"done"`), &status)
+	require.Error(t, err)
+	t.Log(err)
+
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, "done", unknownValueError.Input)
+	require.False(t, status.Valid())
+}
+
+// TestValue_Text tests the text form used in query params and config files
+//
+// 验证用于查询参数和配置文件的文本形式
+func TestValue_Text(t *testing.T) {
+	result := protoenum.NewValue[ResultEnumsHolder](resultEnums.GetByCode(3))
+	text, err := result.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "30", string(text))
+
+	var res Result
+	require.NoError(t, res.UnmarshalText([]byte("10")))
+	require.Equal(t, protoenumresult.ResultEnum_PASS, res.Enum().Proto())
+
+	require.Error(t, res.UnmarshalText([]byte("x")))
+	require.Error(t, res.UnmarshalText([]byte("40")))
+
+	require.NoError(t, res.UnmarshalText([]byte{}))
+	require.False(t, res.Valid())
+
+	text, err = res.MarshalText()
+	require.NoError(t, err)
+	require.Empty(t, text)
+}
+
+// TestValue_SQL tests the sql.Scanner and driver.Valuer forms
+// Checks NULL maps to the zero Value and unknown values return errors
+//
+// 验证 sql.Scanner 和 driver.Valuer 形式
+// 测试 NULL 对应零值 Value，未知值返回错误
+func TestValue_SQL(t *testing.T) {
+	status := protoenum.NewValue[StatusEnumsHolder](statusEnums.GetByCode(2))
+	value, err := status.Value()
+	require.NoError(t, err)
+	require.Equal(t, "failure", value)
+
+	var res Status
+	require.NoError(t, res.Scan([]byte("success")))
+	require.Equal(t, protoenumstatus.StatusEnum_SUCCESS, res.Enum().Proto())
+	require.Equal(t, statusEnums, res.Enums())
+
+	require.NoError(t, res.Scan(nil))
+	require.False(t, res.Valid())
+	value, err = res.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.Error(t, res.Scan("done"))
+
+	var result Result
+	require.NoError(t, result.Scan(int64(20)))
+	require.Equal(t, protoenumresult.ResultEnum_MISS, result.Enum().Proto())
+	value, err = result.Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(20), value)
+}
+
+// TestValue_SQL_MatchSQLValue tests Value behaves the same as SQLValue in the SQLBasic column form
+// Checks written values, NULL handling and the unknown value error agree
+//
+// 验证 Value 与 SQLBasic 列形式的 SQLValue 行为一致
+// 测试写入的值、NULL 处理以及未知值错误均相同
+func TestValue_SQL_MatchSQLValue(t *testing.T) {
+	for _, src := range []any{"success", []byte("failure"), nil, "done"} {
+		var status Status
+		statusErr := status.Scan(src)
+		sqlValue := statusEnums.NewSQLValue(protoenum.SQLBasic, nil)
+		sqlValueErr := sqlValue.Scan(src)
+
+		require.Equal(t, sqlValueErr, statusErr)
+		require.Equal(t, sqlValue.Valid(), status.Valid())
+		require.Equal(t, sqlValue.Enum(), status.Enum())
+
+		value, err := status.Value()
+		require.NoError(t, err)
+		expected, err := sqlValue.Value()
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	}
+
+	var status Status
+	err := status.Scan("done")
+	var unknownValueError *protoenum.UnknownValueError
+	require.True(t, errors.As(err, &unknownValueError))
+	require.Equal(t, protoenum.LookupBasic, unknownValueError.Kind)
+	require.False(t, status.Valid())
+}
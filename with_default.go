@@ -23,11 +23,14 @@ func (c *Enums[P, B, M]) GetDefaultBasic() B {
 // SetDefault sets the default Enum value to return when lookups miss
 // Allows dynamic configuration of the fallback value post creation
 // Panics if defaultEnum is nil, use UnsetDefault to remove the default value
+// Panics with ErrFrozen once the collection is frozen
 //
 // 设置查找失败时返回的默认 Enum 值
 // 允许在创建后动态配置回退值
 // 如果 defaultEnum 为 nil 则会 panic，使用 UnsetDefault 清除默认值
+// 集合冻结后会以 ErrFrozen panic
 func (c *Enums[P, B, M]) SetDefault(enum *Enum[P, B, M]) {
+	c.mustMutable()
 	must.Null(c.defaultValue)
 	// Note: use SetDefaultProto and SetDefaultBasic to validate against map
 	// 注意：使用 SetDefaultProto 或 SetDefaultBasic 可确保值在集合中存在
@@ -38,31 +41,38 @@ func (c *Enums[P, B, M]) SetDefault(enum *Enum[P, B, M]) {
 }
 
 // SetDefaultProto sets the default using a Protocol Buffer enum value
+// Reads the index directly, so configuring a deprecated default does not fire the deprecated hook
 // Panics if the specified proto enum is not found in the collection
 //
 // 使用 Protocol Buffer 枚举值设置默认值
+// 直接读取索引，因此将弃用值配置为默认值不会触发弃用回调
 // 如果指定的 proto 枚举不存在则会 panic
 func (c *Enums[P, B, M]) SetDefaultProto(proto P) {
-	c.SetDefault(c.MustGetByProto(proto))
+	c.SetDefault(must.Full(c.mapProtoEnum[proto]))
 }
 
 // SetDefaultBasic sets the default using a Go native enum value
+// Reads the index directly, so configuring a deprecated default does not fire the deprecated hook
 // Panics if the specified basic enum is not found in the collection
 //
 // 使用 Go 原生枚举值设置默认值
+// 直接读取索引，因此将弃用值配置为默认值不会触发弃用回调
 // 如果指定的 basic 枚举不存在则会 panic
 func (c *Enums[P, B, M]) SetDefaultBasic(basic B) {
-	c.SetDefault(c.MustGetByBasic(basic))
+	c.SetDefault(must.Full(c.mapBasicEnum[basic]))
 }
 
 // SetDefaultValid marks the default value as active when true
 // When active, ListValidProtos and ListValidBasics include the default
 // Panics if no default value exists, panics if defaultValid has been set
+// Panics with ErrFrozen once the collection is frozen
 //
 // 标记默认值是否应被视为有效
 // 当 valid 为 true 时，ListValidProtos 和 ListValidBasics 包含默认值
 // 如果无默认值或 defaultValid 已设置则会 panic
+// 集合冻结后会以 ErrFrozen panic
 func (c *Enums[P, B, M]) SetDefaultValid(valid bool) {
+	c.mustMutable()
 	must.Full(c.defaultValue)
 	must.Null(c.defaultValid)
 	c.defaultValid = &valid
@@ -71,11 +81,14 @@ func (c *Enums[P, B, M]) SetDefaultValid(valid bool) {
 // UnsetDefault unsets the default Enum value
 // Once invoked, GetByXxx lookups panic if not found
 // Panics if no default value exists at the moment
+// Panics with ErrFrozen once the collection is frozen
 //
 // 取消设置默认 Enum 值
 // 调用此方法后，GetByXxx 查找失败时会 panic
 // 如果当前无默认值则会 panic
+// 集合冻结后会以 ErrFrozen panic
 func (c *Enums[P, B, M]) UnsetDefault() {
+	c.mustMutable()
 	must.Full(c.defaultValue)
 	c.defaultValue = nil
 	c.defaultValid = nil